@@ -0,0 +1,156 @@
+package approval
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const activityURL = "https://app.harness.io/gateway/pipeline/api/v1/approvals/{id}/harness/activity"
+
+type ActivityOptions struct {
+	types.ScopeOptions
+	// The identifier of the approval
+	ID string
+	// The comment of the approver
+	Comment string
+	// The approver inputs, in the format of key=value
+	Inputs []string
+	// "approve" or "reject"
+	action string
+}
+
+// ApproverInput is the value of an approver input
+type ApproverInput struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Activity approves or rejects the Harness approval
+type Activity struct {
+	types.ScopedResource `json:"-"`
+	// The identifier of the approval
+	Identifier string `json:"-"`
+	// "APPROVE" or "REJECT"
+	Action         string          `json:"action"`
+	Comments       string          `json:"comments,omitempty"`
+	ApproverInputs []ApproverInput `json:"approverInputs,omitempty"`
+}
+
+// Call implements types.RESTCall
+func (a *Activity) Call() (map[string]interface{}, error) {
+	req := a.Request()
+	req.SetPathParam("id", a.Identifier)
+	return utils.PostJSON(req, activityURL, a)
+}
+
+// Print implements types.RESTCall
+func (a *Activity) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	status := ""
+	if data, ok := rm["data"].(map[string]interface{}); ok {
+		status, _ = data["status"].(string)
+	}
+
+	verb := "approved"
+	if a.Action == "REJECT" {
+		verb = "rejected"
+	}
+
+	fmt.Printf("Approval \"%s\" %s, approval status \"%s\"\n", a.Identifier, verb, status)
+}
+
+// AddFlags implements types.Command
+func (ao *ActivityOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&ao.ID, "id", "", "", fmt.Sprintf("The identifier of the approval to %s.", ao.action))
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringVarP(&ao.Comment, "comment", "c", "", "The comment of the approver.")
+	cmd.Flags().StringArrayVarP(&ao.Inputs, "input", "i", []string{}, "The approver input, in the format of key=value e.g. ticket=CHG-1234.")
+	ao.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (ao *ActivityOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	a := &Activity{
+		ScopedResource: ao.Resource(),
+		Identifier:     ao.ID,
+		Action:         strings.ToUpper(ao.action),
+		Comments:       ao.Comment,
+	}
+
+	for _, kv := range ao.Inputs {
+		k, v, _ := strings.Cut(kv, "=")
+		a.ApproverInputs = append(a.ApproverInputs, ApproverInput{Name: k, Value: v})
+	}
+
+	rm, err := a.Call()
+	a.Print(rm, err)
+	if err != nil || rm["status"] != "SUCCESS" {
+		return fmt.Errorf(`unable to %s the approval "%s"`, ao.action, ao.ID)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ao *ActivityOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	for _, kv := range ao.Inputs {
+		if k, _, ok := strings.Cut(kv, "="); !ok || k == "" {
+			return fmt.Errorf("input should be of format 'key=value'")
+		}
+	}
+
+	return nil
+}
+
+var activityCommandExamples = map[string]string{
+	"approve": fmt.Sprintf(`
+# Approve the approval
+%[1]s approval approve --id <approval id> --account-id <your account id> --project-id <project id> --comment "looks good"
+# Approve the approval with the approver input "ticket"
+%[1]s approval approve --id <approval id> --account-id <your account id> --project-id <project id> --input ticket=CHG-1234
+`, common.ExamplePrefix()),
+	"reject": fmt.Sprintf(`
+# Reject the approval
+%[1]s approval reject --id <approval id> --account-id <your account id> --project-id <project id> --comment "failing smoke tests"
+`, common.ExamplePrefix()),
+}
+
+var activityCommandShorts = map[string]string{
+	"approve": "Approves a Harness approval.",
+	"reject":  "Rejects a Harness approval.",
+}
+
+// newActivityCommands instantiates the new instances of the approval approve and reject commands
+func newActivityCommands() []*cobra.Command {
+	cmds := []*cobra.Command{}
+	for _, action := range []string{"approve", "reject"} {
+		ao := &ActivityOptions{action: action}
+		aCmd := &cobra.Command{
+			Use:     action,
+			Short:   activityCommandShorts[action],
+			Example: activityCommandExamples[action],
+			RunE:    ao.Execute,
+			PreRunE: ao.Validate,
+		}
+		ao.AddFlags(aCmd)
+		cmds = append(cmds, aCmd)
+	}
+	return cmds
+}
+
+var _ types.Command = (*ActivityOptions)(nil)
+var _ types.RESTCall = (*Activity)(nil)
@@ -0,0 +1,36 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package approval
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewApprovalCommands is parent for all the "approval" resource commands
+func NewApprovalCommands() *cobra.Command {
+	aCmd := &cobra.Command{
+		Use:              "approval",
+		Short:            "Group of commands to act on the Harness approvals.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	aCmd.AddCommand(newListCommand())
+	aCmd.AddCommand(newActivityCommands()...)
+
+	return aCmd
+}
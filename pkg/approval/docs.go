@@ -0,0 +1,4 @@
+package approval
+
+// approval package has the commands that will be used to list, approve and reject the Harness approval steps of the pipeline executions.
+// Refer to https://apidocs.harness.io/tag/Approvals for API
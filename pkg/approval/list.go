@@ -0,0 +1,199 @@
+package approval
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/execution"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const executionApprovalsURL = "https://app.harness.io/gateway/pipeline/api/v1/approvals/execution/{id}"
+
+type ListOptions struct {
+	types.ScopeOptions
+	// The identifier of the execution, when empty the approvals of all the executions waiting for approval are listed
+	ExecutionID string
+	// List only the approvals waiting for an action
+	Pending bool
+}
+
+// ListApprovals lists the Harness approvals of an execution
+type ListApprovals struct {
+	types.ScopedResource
+	// The identifier of the execution
+	ExecutionID string
+	// List only the approvals waiting for an action
+	Pending bool
+}
+
+// Call implements types.RESTCall
+func (la *ListApprovals) Call() (map[string]interface{}, error) {
+	req := la.Request()
+	req.SetQueryParam("approvalType", "HarnessApproval")
+	if la.Pending {
+		req.SetQueryParam("approvalStatus", "WAITING")
+	}
+	return utils.GetResourceByID(req, executionApprovalsURL, la.ExecutionID)
+}
+
+// Print implements types.RESTCall
+func (la *ListApprovals) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.SetIndent("", "  ")
+	en.Encode(approvalsFromResponse(rm, la.ExecutionID))
+}
+
+// approvalsFromResponse returns the approvals with their message and approver inputs
+func approvalsFromResponse(rm map[string]interface{}, executionID string) []map[string]interface{} {
+	resMap := []map[string]interface{}{}
+	data, _ := rm["data"].([]interface{})
+	for _, d := range data {
+		a := d.(map[string]interface{})
+		res := map[string]interface{}{
+			"id":          a["id"],
+			"executionId": executionID,
+			"status":      a["status"],
+		}
+		if deadline, ok := a["deadline"].(float64); ok && deadline > 0 {
+			res["deadline"] = time.UnixMilli(int64(deadline)).Format(time.RFC3339)
+		}
+		if details, ok := a["details"].(map[string]interface{}); ok {
+			res["message"] = details["approvalMessage"]
+			inputs := []map[string]interface{}{}
+			if ai, ok := details["approverInputs"].([]interface{}); ok {
+				for _, i := range ai {
+					in := i.(map[string]interface{})
+					inputs = append(inputs, map[string]interface{}{
+						"name":         in["name"],
+						"defaultValue": in["defaultValue"],
+					})
+				}
+			}
+			res["approverInputs"] = inputs
+			if approvers, ok := details["approvers"].(map[string]interface{}); ok {
+				res["userGroups"] = approvers["userGroups"]
+				res["minimumCount"] = approvers["minimumCount"]
+			}
+		}
+		resMap = append(resMap, res)
+	}
+	return resMap
+}
+
+// waitingExecutions returns the identifiers of the executions of the project waiting for approval
+func waitingExecutions(r types.ScopedResource) ([]string, error) {
+	le := &execution.ListExecutions{
+		ScopedResource: r,
+		Limit:          100,
+		FilterType:     "PipelineExecution",
+		Status:         []string{"ApprovalWaiting"},
+	}
+
+	rm, err := le.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return nil, fmt.Errorf("unable to list the executions waiting for approval, %v", rm["message"])
+	}
+
+	ids := []string{}
+	data := rm["data"].(map[string]interface{})
+	content, _ := data["content"].([]interface{})
+	for _, c := range content {
+		if id, ok := c.(map[string]interface{})["planExecutionId"].(string); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.ExecutionID, "execution-id", "e", "", "The identifier of the execution, when not set the approvals of all the executions waiting for approval are listed.")
+	cmd.Flags().BoolVarP(&lo.Pending, "pending", "", false, "List only the approvals waiting for an action.")
+	lo.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	la := &ListApprovals{
+		ScopedResource: lo.Resource(),
+		ExecutionID:    lo.ExecutionID,
+		Pending:        lo.Pending,
+	}
+
+	if la.ExecutionID != "" {
+		la.Print(la.Call())
+		return nil
+	}
+
+	ids, err := waitingExecutions(la.ScopedResource)
+	if err != nil {
+		return err
+	}
+
+	resMap := []map[string]interface{}{}
+	for _, id := range ids {
+		la.ExecutionID = id
+		rm, err := la.Call()
+		if err != nil || rm["status"] != "SUCCESS" {
+			log.Warnf("Unable to list the approvals of execution %s, %v %v", id, err, rm["message"])
+			continue
+		}
+		resMap = append(resMap, approvalsFromResponse(rm, id)...)
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.SetIndent("", "  ")
+	en.Encode(resMap)
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the pending approvals of the project
+%[1]s approval list --pending --account-id <your account id> --project-id <project id>
+# List all the approvals of the execution
+%[1]s approval list --execution-id <execution id> --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	lCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the Harness approvals of the project or an execution.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(lCmd)
+
+	return lCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListApprovals)(nil)
@@ -0,0 +1,3 @@
+package artifactory
+
+// artifactory package defines the commands to manipulate JFrog Artifactory connector resource
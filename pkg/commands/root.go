@@ -21,10 +21,24 @@ import (
 	"os"
 	"strings"
 
+	"github.com/kameshsampath/harness-cli/pkg/approval"
 	"github.com/kameshsampath/harness-cli/pkg/connector"
 	"github.com/kameshsampath/harness-cli/pkg/delegate"
+	"github.com/kameshsampath/harness-cli/pkg/environment"
+	"github.com/kameshsampath/harness-cli/pkg/execution"
+	"github.com/kameshsampath/harness-cli/pkg/filestore"
+	"github.com/kameshsampath/harness-cli/pkg/infrastructure"
+	"github.com/kameshsampath/harness-cli/pkg/inputset"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
 	"github.com/kameshsampath/harness-cli/pkg/project"
+	"github.com/kameshsampath/harness-cli/pkg/role"
 	"github.com/kameshsampath/harness-cli/pkg/secret"
+	"github.com/kameshsampath/harness-cli/pkg/service"
+	"github.com/kameshsampath/harness-cli/pkg/template"
+	"github.com/kameshsampath/harness-cli/pkg/trigger"
+	"github.com/kameshsampath/harness-cli/pkg/user"
+	"github.com/kameshsampath/harness-cli/pkg/usergroup"
+	"github.com/kameshsampath/harness-cli/pkg/variable"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -63,9 +77,23 @@ func NewRootCommand() *cobra.Command {
 	//Commands
 	rootCmd.AddCommand(NewVersionCommand())
 	rootCmd.AddCommand(project.NewProjectCommands())
+	rootCmd.AddCommand(user.NewUserCommands())
+	rootCmd.AddCommand(usergroup.NewUserGroupCommands())
+	rootCmd.AddCommand(role.NewRoleCommands())
 	rootCmd.AddCommand(secret.NewSecretCommands())
+	rootCmd.AddCommand(variable.NewVariableCommands())
+	rootCmd.AddCommand(filestore.NewFileStoreCommands())
 	rootCmd.AddCommand(connector.NewConnectorsCommands())
 	rootCmd.AddCommand(delegate.NewDelegateCommands())
+	rootCmd.AddCommand(service.NewServiceCommands())
+	rootCmd.AddCommand(environment.NewEnvironmentCommands())
+	rootCmd.AddCommand(infrastructure.NewInfrastructureCommands())
+	rootCmd.AddCommand(pipeline.NewPipelineCommands())
+	rootCmd.AddCommand(inputset.NewInputSetCommands())
+	rootCmd.AddCommand(template.NewTemplateCommands())
+	rootCmd.AddCommand(trigger.NewTriggerCommands())
+	rootCmd.AddCommand(execution.NewExecutionCommands())
+	rootCmd.AddCommand(approval.NewApprovalCommands())
 
 	return rootCmd
 }
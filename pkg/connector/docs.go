@@ -1,4 +1,5 @@
 package connector
 
-// connector package defines the command to manipulate the Harness connector resources.The package defines commands to create/delete GitHub, GCP and Docker Registry connectors. For more information about the Connectors API consult the official API doc https://apidocs.harness.io/tag/Connectors
+// connector package defines the command to manipulate the Harness connector resources.The package defines commands to create/delete GitHub, GCP, Docker Registry, Artifactory, Nexus, Helm repository, Jira, ServiceNow and monitoring connectors.
+// Connector types without a dedicated command can be created from a raw spec file with "connectors new". For more information about the Connectors API consult the official API doc https://apidocs.harness.io/tag/Connectors
 // All commands in the package currently supports only Create and Delete verbs
@@ -0,0 +1,175 @@
+package connector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+type CreateOptions struct {
+	Name        string
+	Description string
+	ProjectID   string
+	// account, org, project
+	Scope string
+	// The Harness connector type e.g. "K8sCluster", "Gitlab", "Aws"
+	Type string
+	// The YAML or JSON file holding the connector spec
+	SpecFile string
+	// The local Harness connector JSON schema used to validate the connector
+	SchemaFile string
+	Tags       []string
+
+	spec interface{}
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the connector.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&co.Type, "type", "", "", `The Harness connector type e.g. "K8sCluster", "Gitlab", "Aws". Refer to https://apidocs.harness.io/tag/Connectors for valid types`)
+	cmd.MarkFlagRequired("type")
+	cmd.Flags().StringVarP(&co.SpecFile, "spec-file", "f", "", "The YAML or JSON file with the connector spec.")
+	cmd.MarkFlagRequired("spec-file")
+	cmd.Flags().StringVarP(&co.SchemaFile, "schema-file", "", "", "The local Harness connector JSON schema file, when set the connector is validated against it before creation.")
+	cmd.Flags().StringVarP(&co.Description, "description", "d", "", "The description for the connector.")
+	cmd.Flags().StringArrayVarP(&co.Tags, "tags", "t", []string{}, "The tags to attach to the connector, in the format of key:value e.g. foo:bar.")
+	cmd.Flags().StringVarP(&co.ProjectID, "project-id", "p", "", `The project where the connector will be created.`)
+	cmd.Flags().StringVarP(&co.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	ci := co.connectorInfo()
+
+	if co.SchemaFile != "" {
+		if err := validateSchema(co.SchemaFile, ci); err != nil {
+			return err
+		}
+	}
+
+	ci.Call()
+
+	return nil
+}
+
+// connectorInfo wraps the spec in to the connector
+func (co *CreateOptions) connectorInfo() *types.ConnectorInfo {
+	c := &types.Connector{
+		APIKey:      viper.GetString("api-key"),
+		AccountID:   viper.GetString("account-id"),
+		Name:        co.Name,
+		Identifier:  utils.IDFromName(co.Name),
+		Description: co.Description,
+		Type:        co.Type,
+		Scope:       co.Scope,
+		Spec:        co.spec,
+	}
+
+	if co.Scope == "project" {
+		c.OrgID = viper.GetString("org-id")
+		c.ProjectID = co.ProjectID
+	} else if co.Scope == "org" {
+		c.OrgID = viper.GetString("org-id")
+	}
+
+	c.Tags = utils.TagMapFromStringArray(co.Tags)
+
+	return &types.ConnectorInfo{
+		ConnectorInfo: *c,
+	}
+}
+
+// validateSchema validates the connector against the JSON schema in schemaFile
+func validateSchema(schemaFile string, ci *types.ConnectorInfo) error {
+	log.Infof("Validating connector with schema %s", schemaFile)
+
+	sch, err := jsonschema.Compile(schemaFile)
+	if err != nil {
+		return fmt.Errorf("unable to load schema %s, %w", schemaFile, err)
+	}
+
+	b, err := json.Marshal(ci)
+	if err != nil {
+		return err
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+
+	return sch.Validate(v)
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	co.SchemaFile = viper.GetString("schema-file")
+
+	b, err := os.ReadFile(co.SpecFile)
+	if err != nil {
+		return err
+	}
+
+	// YAML is a superset of JSON, the same decoder handles both
+	if err := yaml.Unmarshal(b, &co.spec); err != nil {
+		return fmt.Errorf("unable to parse spec file %s, %w", co.SpecFile, err)
+	}
+
+	if _, ok := co.spec.(map[string]interface{}); !ok {
+		return fmt.Errorf("spec file %s should have the connector spec as an object", co.SpecFile)
+	}
+
+	if len(co.Tags) > 0 {
+		for _, t := range co.Tags {
+			if !strings.Contains(t, ":") {
+				return fmt.Errorf("tags should be of format 'key:value'")
+			}
+		}
+	}
+
+	return nil
+}
+
+var newCommandExample = fmt.Sprintf(`
+  # Create a Kubernetes cluster connector from the spec in k8s.yaml
+  %[1]s connectors new --name my-cluster --type K8sCluster --spec-file k8s.yaml --account-id <your account id> --project-id <project id>
+  # Create a GitLab connector at account scope from a JSON spec
+  %[1]s connectors new --name gitlab --type Gitlab --spec-file gitlab.json --account-id <your account id> --connector-scope="account"
+  # Validate the connector against the local Harness connector schema before creating it
+  %[1]s connectors new --name my-cluster --type K8sCluster --spec-file k8s.yaml --schema-file connector.json --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// NewCreateConnectorCommand instantiates the new instance of the NewCreateConnectorCommand
+func NewCreateConnectorCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	ncCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new connector of any type from a spec file.",
+		Example: newCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(ncCmd)
+
+	return ncCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
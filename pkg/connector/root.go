@@ -17,9 +17,15 @@
 package connector
 
 import (
+	"github.com/kameshsampath/harness-cli/pkg/artifactory"
 	"github.com/kameshsampath/harness-cli/pkg/docker"
 	"github.com/kameshsampath/harness-cli/pkg/gcp"
 	"github.com/kameshsampath/harness-cli/pkg/github"
+	"github.com/kameshsampath/harness-cli/pkg/helm"
+	"github.com/kameshsampath/harness-cli/pkg/jira"
+	"github.com/kameshsampath/harness-cli/pkg/monitoring"
+	"github.com/kameshsampath/harness-cli/pkg/nexus"
+	"github.com/kameshsampath/harness-cli/pkg/servicenow"
 	"github.com/spf13/cobra"
 )
 
@@ -35,6 +41,13 @@ func NewConnectorsCommands() *cobra.Command {
 	connCmd.AddCommand(github.NewGitHubConnectorCommands())
 	connCmd.AddCommand(docker.NewDockerConnectorCommands())
 	connCmd.AddCommand(gcp.NewGCPConnectorCommands())
+	connCmd.AddCommand(artifactory.NewArtifactoryConnectorCommands())
+	connCmd.AddCommand(nexus.NewNexusConnectorCommands())
+	connCmd.AddCommand(helm.NewHelmConnectorCommands())
+	connCmd.AddCommand(jira.NewJiraConnectorCommands())
+	connCmd.AddCommand(servicenow.NewServiceNowConnectorCommands())
+	connCmd.AddCommand(monitoring.NewMonitoringConnectorCommands())
+	connCmd.AddCommand(NewCreateConnectorCommand())
 	connCmd.AddCommand(NewDeleteConnectorCommand())
 
 	return connCmd
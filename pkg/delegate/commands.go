@@ -31,6 +31,10 @@ func NewDelegateCommands() *cobra.Command {
 
 	//Commands
 	dCmd.AddCommand(newListCommand())
+	dCmd.AddCommand(newInstallManifestCommand())
+	dCmd.AddCommand(newTokenCommands())
+	dCmd.AddCommand(newWaitCommand())
+	dCmd.AddCommand(newTagsCommands())
 
 	return dCmd
 }
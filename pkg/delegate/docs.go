@@ -1,5 +1,5 @@
 package delegate
 
 // delegate package has the commands that will be used to manipulate the Harness Delegates.
-// This package has only one command which is used list the delegates by tags
+// This package has commands to list the delegates by tags, to generate the delegate install manifests, to manage the delegate tokens and the delegate tags
 // Refer to https://apidocs.harness.io/tag/Delegate-Group-Tags-Resource for API
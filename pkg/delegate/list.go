@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/kameshsampath/harness-cli/pkg/common"
 	"github.com/kameshsampath/harness-cli/pkg/types"
@@ -14,12 +15,11 @@ import (
 )
 
 type ListOptions struct {
-	// The project identifier used to identify the project
-	ProjectID string
-	// The scope of the resource "account", "org" or "project"
-	Scope string
+	types.ScopeOptions
 	// The tags that will be used to filter the delegate
 	Tags []string
+	// The status that will be used to filter the delegate "connected", "disconnected" or "outdated"
+	Status string
 }
 
 type List struct {
@@ -33,14 +33,86 @@ type List struct {
 	OrgID string `json:"-"`
 	// The scope of the resource "account", "org" or "project"
 	Scope string `json:"-"`
+	// The status that will be used to filter the delegate "connected", "disconnected" or "outdated"
+	Status string `json:"-"`
+	// Always "Delegate"
+	FilterType string `json:"filterType"`
 	// The tags that will be used to filter the delegate
-	Tags []string `json:"tags"`
+	Tags []string `json:"delegateTags,omitempty"`
+}
+
+// Instance is a delegate instance of the delegate group
+type Instance struct {
+	HostName          string `json:"hostName"`
+	Version           string `json:"version"`
+	LastHeartbeat     int64  `json:"lastHeartbeat"`
+	ActivelyConnected bool   `json:"activelyConnected"`
+}
+
+// Group is the delegate group with its instances
+type Group struct {
+	Name               string            `json:"groupName"`
+	Identifier         string            `json:"delegateGroupIdentifier"`
+	Type               string            `json:"delegateType"`
+	ConnectivityStatus string            `json:"connectivityStatus"`
+	ActivelyConnected  bool              `json:"activelyConnected"`
+	LastHeartBeat      int64             `json:"lastHeartBeat"`
+	Version            string            `json:"groupVersion"`
+	ExpirationTime     int64             `json:"delegateGroupExpirationTime"`
+	ImplicitSelectors  map[string]string `json:"groupImplicitSelectors"`
+	CustomSelectors    []string          `json:"groupCustomSelectors"`
+	Instances          []Instance        `json:"delegateInstanceDetails"`
+}
+
+// Status returns "connected" or "disconnected"
+func (g *Group) Status() string {
+	if g.ActivelyConnected || g.ConnectivityStatus == "connected" {
+		return "connected"
+	}
+	return "disconnected"
+}
+
+// Outdated returns true when the delegate group version has expired
+func (g *Group) Outdated() bool {
+	return g.ExpirationTime > 0 && time.UnixMilli(g.ExpirationTime).Before(time.Now())
+}
+
+// ConnectedInstances returns the number of instances that are actively connected
+func (g *Group) ConnectedInstances() int {
+	n := 0
+	for _, i := range g.Instances {
+		if i.ActivelyConnected {
+			n++
+		}
+	}
+	return n
+}
+
+// InstanceVersion returns the group version, or the version of the first instance when the group has none
+func (g *Group) InstanceVersion() string {
+	if g.Version == "" && len(g.Instances) > 0 {
+		return g.Instances[0].Version
+	}
+	return g.Version
+}
+
+// Matches checks if the group matches the status "connected", "disconnected" or "outdated"
+func (g *Group) Matches(status string) bool {
+	switch status {
+	case "":
+		return true
+	case "outdated":
+		return g.Outdated()
+	default:
+		return g.Status() == status
+	}
 }
 
 // AddFlags implements types.Command
 func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
-	cmd.Flags().StringVarP(&lo.Scope, "delegate-scope", "", "project", `The scope of the delegate. Valid value is one of "project", "org", "account"`)
+	lo.ScopeOptions.AddFlags(cmd, "delegate")
 	cmd.Flags().StringSliceVarP(&lo.Tags, "tags", "t", []string{}, "The tags that will be used to filter the delegate")
+	cmd.Flags().StringVarP(&lo.Status, "status", "", "", `The status that will be used to filter the delegate. Valid value is one of "connected", "disconnected", "outdated". "outdated" delegates are the ones whose version has expired`)
 }
 
 // Call implements common.RESTCall
@@ -50,24 +122,55 @@ func (l *List) Call() (map[string]interface{}, error) {
 
 	log.Infof("Getting list of delegates for tags %v ", l.Tags)
 
-	return utils.PostJSON(req, "https://app.harness.io/gateway/ng/api/delegate-group-tags/delegate-groups", l)
+	return utils.PostJSON(req, "https://app.harness.io/gateway/ng/api/delegate-setup/listV2", l)
+}
+
+// Groups calls the API and returns the delegate groups that match the status
+func (l *List) Groups() ([]Group, error) {
+	return groupsFromResponse(l.Call())
+}
+
+// groupsFromResponse parses the delegate groups from the listV2 API response
+func groupsFromResponse(rm map[string]interface{}, err error) ([]Group, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("%#v", rm)
+	if v, ok := rm["status"]; ok && v == "ERROR" {
+		return nil, fmt.Errorf("%s", rm["message"])
+	}
+
+	resource, ok := rm["resource"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response %#v", rm)
+	}
+
+	b, err := json.Marshal(resource["delegateGroupDetails"])
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []Group
+	if err := json.Unmarshal(b, &groups); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
 }
 
 // Execute implements types.Command
 func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
 	l := &List{
-		APIKey:    viper.GetString("api-key"),
-		AccountID: viper.GetString("account-id"),
-		Tags:      lo.Tags,
-		Scope:     lo.Scope,
+		APIKey:     viper.GetString("api-key"),
+		AccountID:  viper.GetString("account-id"),
+		FilterType: "Delegate",
+		Tags:       lo.Tags,
+		Scope:      lo.Scope,
+		Status:     lo.Status,
 	}
 
-	if lo.Scope == "project" {
-		l.OrgID = viper.GetString("org-id")
-		l.ProjectIdentifier = lo.ProjectID
-	} else if lo.Scope == "org" {
-		l.OrgID = viper.GetString("org-id")
-	}
+	l.OrgID, l.ProjectIdentifier = lo.ScopedIDs()
 
 	l.Print(l.Call())
 
@@ -76,42 +179,58 @@ func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
 
 // Print implements types.Command
 func (l *List) Print(rm map[string]interface{}, err error) {
+	groups, err := groupsFromResponse(rm, err)
 	if err != nil {
 		log.Errorf("%s", err)
+		return
 	}
 
-	log.Tracef("%#v", rm)
-	if v, ok := rm["status"]; ok && v == "ERROR" {
-		fmt.Printf(rm["message"].(string))
-	}
-
-	var resMap []map[string]string
-	if v, ok := rm["resource"]; ok {
-		resources := v.([]interface{})
-		for _, r := range resources {
-			res := r.(map[string]interface{})
-			id := res["identifier"].(string)
-			name := res["name"].(string)
-			resMap = append(resMap, map[string]string{
-				"name": name,
-				"id":   id,
-			})
+	resMap := []map[string]interface{}{}
+	for _, g := range groups {
+		if !g.Matches(l.Status) {
+			continue
+		}
+		res := map[string]interface{}{
+			"name":               g.Name,
+			"id":                 g.Identifier,
+			"type":               g.Type,
+			"status":             g.Status(),
+			"version":            g.InstanceVersion(),
+			"outdated":           g.Outdated(),
+			"instances":          len(g.Instances),
+			"connectedInstances": g.ConnectedInstances(),
+			"tags":               g.CustomSelectors,
 		}
-		en := json.NewEncoder(os.Stdout)
-		en.Encode(resMap)
+		if g.LastHeartBeat > 0 {
+			res["lastHeartbeat"] = time.UnixMilli(g.LastHeartBeat).Format(time.RFC3339)
+		}
+		resMap = append(resMap, res)
 	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
 }
 
 // Validate implements types.Command
 func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
 	viper.BindPFlags(cmd.Flags())
-	return nil
+
+	switch lo.Status {
+	case "", "connected", "disconnected", "outdated":
+	default:
+		return fmt.Errorf(`"status" should be one of "connected", "disconnected" or "outdated"`)
+	}
+
+	return lo.ScopeOptions.Validate()
 }
 
-// (TODO:kamesh) update the examples
 var listCommandExample = fmt.Sprintf(`
 # List existing delegates
-%[1]s delegate list --account-id <your account id> --project-id <project id> --tag "foo" --tag "bar"
+%[1]s delegate list --account-id <your account id> --project-id <project id> --tags "foo" --tags "bar"
+# List the disconnected delegates of the project
+%[1]s delegate list --account-id <your account id> --project-id <project id> --status disconnected
+# List the outdated account level delegates
+%[1]s delegate list --account-id <your account id> --delegate-scope account --status outdated
 `, common.ExamplePrefix())
 
 // newListCommand instantiates the new instance of the delegate list command
@@ -120,7 +239,7 @@ func newListCommand() *cobra.Command {
 
 	ldCmd := &cobra.Command{
 		Use:     "list",
-		Short:   "List existing delegates by tag",
+		Short:   "List existing delegates with their status, version and heartbeat",
 		Example: listCommandExample,
 		RunE:    lo.Execute,
 		PreRunE: lo.Validate,
@@ -0,0 +1,208 @@
+package delegate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// manifestURLs maps the manifest type to the API that generates it
+var manifestURLs = map[string]string{
+	"kubernetes":     "https://app.harness.io/gateway/ng/api/download-delegates/kubernetes",
+	"docker-compose": "https://app.harness.io/gateway/ng/api/download-delegates/docker",
+	"helm-values":    "https://app.harness.io/gateway/ng/api/delegate-setup/generate-helm-values",
+	"terraform":      "https://app.harness.io/gateway/ng/api/delegate-setup/generate-terraform-module-file",
+}
+
+type InstallManifestOptions struct {
+	types.ScopeOptions
+	// The name of the delegate
+	Name        string
+	Description string
+	// The tags that will be attached to the delegate
+	Tags []string
+	// The delegate token that the delegate will use to authenticate
+	TokenName string
+	// "kubernetes", "helm-values", "docker-compose" or "terraform"
+	Type string
+	// The file to write the manifest to, defaults to stdout
+	Output string
+	// The proxy the delegate will use to connect to Harness e.g. http://proxy.example.com:3128
+	ProxyURL string
+	NoProxy  string
+	// The CPU and memory of the delegate e.g. "1", "2Gi"
+	CPU    string
+	Memory string
+}
+
+type K8sConfigDetails struct {
+	// "CLUSTER_ADMIN" "CLUSTER_VIEWER" "NAMESPACE_ADMIN"
+	K8sPermissionType string `json:"k8sPermissionType"`
+}
+
+type InstallManifest struct {
+	// APIKey holds the API Key for the API calls
+	APIKey string `json:"-"`
+	// AccountID holds the AccountID that will be used for API calls
+	AccountID string `json:"-"`
+	// ProjectIdentifier the project identifier will attach the resource the project
+	ProjectIdentifier string `json:"-"`
+	// OrgID the organisation ID under which the project "ProjectIdentifier" resides
+	OrgID string `json:"-"`
+	// The scope of the resource "account", "org" or "project"
+	Scope string `json:"-"`
+	// "kubernetes", "helm-values", "docker-compose" or "terraform"
+	Type string `json:"-"`
+	// The proxy and resource settings to inject in the manifest
+	Settings *Settings `json:"-"`
+
+	Name                  string            `json:"name"`
+	Identifier            string            `json:"identifier,omitempty"`
+	Description           string            `json:"description,omitempty"`
+	Tags                  []string          `json:"tags,omitempty"`
+	TokenName             string            `json:"tokenName,omitempty"`
+	ClusterPermissionType string            `json:"clusterPermissionType,omitempty"`
+	DelegateType          string            `json:"delegateType,omitempty"`
+	Size                  string            `json:"size,omitempty"`
+	K8sConfigDetails      *K8sConfigDetails `json:"k8sConfigDetails,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (mo *InstallManifestOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&mo.Name, "name", "n", "", "The name of the delegate.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&mo.Type, "type", "", "kubernetes", `The type of the install manifest. Valid value is one of "kubernetes", "helm-values", "docker-compose", "terraform"`)
+	cmd.Flags().StringVarP(&mo.Description, "description", "d", "", "The description for the delegate.")
+	cmd.Flags().StringSliceVarP(&mo.Tags, "tags", "t", []string{}, "The tags to attach to the delegate")
+	cmd.Flags().StringVarP(&mo.TokenName, "token-name", "", "default_token", "The name of the delegate token the delegate will use.")
+	mo.ScopeOptions.AddFlags(cmd, "delegate")
+	cmd.Flags().StringVarP(&mo.Output, "output", "", "", "The file to write the manifest to, defaults to stdout.")
+	cmd.Flags().StringVarP(&mo.ProxyURL, "proxy-url", "", "", "The proxy the delegate will use to connect to Harness e.g. http://proxy.example.com:3128")
+	cmd.Flags().StringVarP(&mo.NoProxy, "no-proxy", "", "", "The comma separated list of hosts that should not use the proxy.")
+	cmd.Flags().StringVarP(&mo.CPU, "cpu", "", "", `The CPU to allocate to the delegate e.g. "1"`)
+	cmd.Flags().StringVarP(&mo.Memory, "memory", "", "", `The memory to allocate to the delegate e.g. "2048Mi" or "2Gi"`)
+}
+
+// Execute implements types.Command
+func (mo *InstallManifestOptions) Execute(cmd *cobra.Command, args []string) error {
+	im := &InstallManifest{
+		APIKey:      viper.GetString("api-key"),
+		AccountID:   viper.GetString("account-id"),
+		Scope:       mo.Scope,
+		Type:        mo.Type,
+		Name:        mo.Name,
+		Description: mo.Description,
+		Tags:        mo.Tags,
+		TokenName:   mo.TokenName,
+	}
+
+	im.OrgID, im.ProjectIdentifier = mo.ScopedIDs()
+
+	switch mo.Type {
+	case "kubernetes":
+		im.ClusterPermissionType = "CLUSTER_ADMIN"
+	case "helm-values", "terraform":
+		im.Identifier = utils.IDFromName(mo.Name)
+		im.Size = "LAPTOP"
+		im.DelegateType = "HELM_DELEGATE"
+		if mo.Type == "terraform" {
+			im.DelegateType = "KUBERNETES"
+		}
+		im.K8sConfigDetails = &K8sConfigDetails{
+			K8sPermissionType: "CLUSTER_ADMIN",
+		}
+	}
+
+	settings, err := newSettings(mo.ProxyURL, mo.NoProxy, mo.CPU, mo.Memory)
+	if err != nil {
+		return err
+	}
+	im.Settings = settings
+
+	b, err := im.Fetch()
+	if err != nil {
+		return err
+	}
+
+	if mo.Output == "" {
+		_, err = cmd.OutOrStdout().Write(b)
+		return err
+	}
+
+	if err := os.WriteFile(mo.Output, b, 0600); err != nil {
+		return err
+	}
+	log.Infof("Delegate %s manifest written to %s", mo.Type, mo.Output)
+
+	return nil
+}
+
+// Fetch downloads the install manifest and injects the proxy and resource settings
+func (im *InstallManifest) Fetch() ([]byte, error) {
+	req := utils.NewHTTPRequest(im.APIKey, im.AccountID)
+	utils.AddScopedIDQueryParams(req, im.Scope, im.OrgID, im.ProjectIdentifier)
+
+	log.Infof("Generating %s install manifest for delegate %s", im.Type, im.Name)
+
+	b, err := utils.PostJSONForContent(req, manifestURLs[im.Type], im)
+	if err != nil {
+		return nil, err
+	}
+
+	if im.Settings == nil {
+		return b, nil
+	}
+
+	return im.Settings.Inject(im.Type, b)
+}
+
+// Validate implements types.Command
+func (mo *InstallManifestOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if _, ok := manifestURLs[mo.Type]; !ok {
+		return fmt.Errorf(`"type" should be one of "kubernetes", "helm-values", "docker-compose", "terraform"`)
+	}
+
+	if mo.Type == "terraform" && (mo.ProxyURL != "" || mo.NoProxy != "" || mo.CPU != "" || mo.Memory != "") {
+		return fmt.Errorf(`proxy and resource settings are not supported for "terraform", set them as module variables instead`)
+	}
+
+	return nil
+}
+
+var installManifestCommandExample = fmt.Sprintf(`
+# Generate the Kubernetes manifest of the delegate and print it
+%[1]s delegate install-manifest --name my-delegate --account-id <your account id> --project-id <project id> --tags "foo,bar"
+# Generate the Helm values of an account level delegate and write it to a file
+%[1]s delegate install-manifest --type helm-values --name my-delegate --account-id <your account id> --delegate-scope account --output values.yaml
+# Generate the docker compose file of the delegate that connects through a proxy
+%[1]s delegate install-manifest --type docker-compose --name my-delegate --account-id <your account id> --project-id <project id> --proxy-url http://proxy.example.com:3128 --no-proxy "localhost,.internal"
+# Generate the Kubernetes manifest of the delegate with 1 CPU and 2Gi of memory
+%[1]s delegate install-manifest --name my-delegate --account-id <your account id> --project-id <project id> --cpu 1 --memory 2Gi
+`, common.ExamplePrefix())
+
+// newInstallManifestCommand instantiates the new instance of the delegate install-manifest command
+func newInstallManifestCommand() *cobra.Command {
+	mo := &InstallManifestOptions{}
+
+	imCmd := &cobra.Command{
+		Use:     "install-manifest",
+		Short:   "Generates the install manifest of a delegate",
+		Example: installManifestCommandExample,
+		RunE:    mo.Execute,
+		PreRunE: mo.Validate,
+	}
+
+	mo.AddFlags(imCmd)
+
+	return imCmd
+}
+
+var _ types.Command = (*InstallManifestOptions)(nil)
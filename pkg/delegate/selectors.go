@@ -0,0 +1,52 @@
+package delegate
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// WarnOnUnmatchedSelectors logs a warning when none of the selectors match a delegate
+// available to a resource at the scope, i.e. the delegates of the scope and its parent scopes
+func WarnOnUnmatchedSelectors(apiKey, accountID, scope, orgID, projectID string, selectors []string) {
+	if len(selectors) == 0 {
+		return
+	}
+
+	lists := []*List{{Scope: "account"}}
+	if scope == "org" || scope == "project" {
+		lists = append(lists, &List{Scope: "org", OrgID: orgID})
+	}
+	if scope == "project" {
+		lists = append(lists, &List{Scope: "project", OrgID: orgID, ProjectIdentifier: projectID})
+	}
+
+	available := map[string]bool{}
+	for _, l := range lists {
+		l.APIKey = apiKey
+		l.AccountID = accountID
+		l.FilterType = "Delegate"
+
+		groups, err := l.Groups()
+		if err != nil {
+			log.Warnf("Unable to check the delegate tags %v, %s", selectors, err)
+			return
+		}
+
+		for _, g := range groups {
+			available[g.Name] = true
+			for s := range g.ImplicitSelectors {
+				available[s] = true
+			}
+			for _, s := range g.CustomSelectors {
+				available[s] = true
+			}
+		}
+	}
+
+	for _, s := range selectors {
+		if available[s] {
+			return
+		}
+	}
+
+	log.Warnf("None of the delegate tags %v match an existing delegate", selectors)
+}
@@ -0,0 +1,299 @@
+package delegate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// Settings holds the proxy and resource settings that will be injected in to
+// the delegate install manifest
+type Settings struct {
+	ProxyScheme string
+	ProxyHost   string
+	ProxyPort   string
+	NoProxy     string
+	CPU         string
+	// Memory in Kubernetes quantity format e.g. 2Gi
+	Memory string
+	// Memory in MiB
+	memoryMiB int
+}
+
+// newSettings builds the settings from the flags, returns nil when there is nothing to inject
+func newSettings(proxyURL, noProxy, cpu, memory string) (*Settings, error) {
+	if proxyURL == "" && noProxy == "" && cpu == "" && memory == "" {
+		return nil, nil
+	}
+
+	s := &Settings{
+		NoProxy: noProxy,
+		CPU:     cpu,
+		Memory:  memory,
+	}
+
+	if proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil || u.Scheme == "" || u.Hostname() == "" {
+			return nil, fmt.Errorf(`"proxy-url" %q should be of format <scheme>://<host>:<port>`, proxyURL)
+		}
+		s.ProxyScheme = u.Scheme
+		s.ProxyHost = u.Hostname()
+		s.ProxyPort = u.Port()
+	}
+
+	if memory != "" {
+		mib, err := memoryInMiB(memory)
+		if err != nil {
+			return nil, err
+		}
+		s.memoryMiB = mib
+	}
+
+	return s, nil
+}
+
+// memoryInMiB converts the memory quantities like 2Gi, 2048Mi, 2G to MiB
+func memoryInMiB(memory string) (int, error) {
+	units := []struct {
+		suffix     string
+		multiplier float64
+	}{
+		{"Gi", 1024},
+		{"Mi", 1},
+		{"G", 1000.0 * 1000 * 1000 / (1024 * 1024)},
+		{"M", 1000.0 * 1000 / (1024 * 1024)},
+	}
+
+	for _, u := range units {
+		if strings.HasSuffix(memory, u.suffix) {
+			v, err := strconv.ParseFloat(strings.TrimSuffix(memory, u.suffix), 64)
+			if err == nil && v > 0 {
+				return int(v * u.multiplier), nil
+			}
+			break
+		}
+	}
+
+	return 0, fmt.Errorf(`"memory" %q should be a quantity like "2Gi" or "2048Mi"`, memory)
+}
+
+// env returns the delegate environment variables for the proxy settings
+func (s *Settings) env() [][2]string {
+	var env [][2]string
+	if s.ProxyHost != "" {
+		env = append(env,
+			[2]string{"PROXY_HOST", s.ProxyHost},
+			[2]string{"PROXY_PORT", s.ProxyPort},
+			[2]string{"PROXY_SCHEME", s.ProxyScheme},
+			[2]string{"PROXY_MANAGER", "true"})
+	}
+	if s.NoProxy != "" {
+		env = append(env, [2]string{"NO_PROXY", s.NoProxy})
+	}
+	return env
+}
+
+// Inject injects the settings in to the manifest of type manifestType
+func (s *Settings) Inject(manifestType string, manifest []byte) ([]byte, error) {
+	docs, err := decodeDocuments(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, doc := range docs {
+		// the documents that are not a mapping e.g. empty ones are kept as is
+		if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+			continue
+		}
+		root := doc.Content[0]
+		switch manifestType {
+		case "kubernetes":
+			s.injectKubernetes(root)
+		case "docker-compose":
+			s.injectDockerCompose(root)
+		case "helm-values":
+			s.injectHelmValues(root)
+		default:
+			return nil, fmt.Errorf("settings can't be injected in to %s manifest", manifestType)
+		}
+	}
+
+	var buf bytes.Buffer
+	en := yaml.NewEncoder(&buf)
+	en.SetIndent(2)
+	for _, doc := range docs {
+		if err := en.Encode(doc); err != nil {
+			return nil, err
+		}
+	}
+	en.Close()
+
+	return buf.Bytes(), nil
+}
+
+func (s *Settings) injectKubernetes(root *yaml.Node) {
+	kind := utils.MappingValue(root, "kind")
+	if kind == nil || (kind.Value != "StatefulSet" && kind.Value != "Deployment") {
+		return
+	}
+
+	containers := utils.MappingValue(ensureMapping(ensureMapping(ensureMapping(root, "spec"), "template"), "spec"), "containers")
+	if containers == nil || len(containers.Content) == 0 {
+		return
+	}
+	container := containers.Content[0]
+
+	if env := s.env(); len(env) > 0 {
+		envs := utils.MappingValue(container, "env")
+		if envs == nil {
+			envs = &yaml.Node{Kind: yaml.SequenceNode}
+			setValue(container, "env", envs)
+		}
+		for _, e := range env {
+			setEnvVar(envs, e[0], e[1])
+		}
+	}
+
+	if s.CPU != "" || s.Memory != "" {
+		resources := ensureMapping(container, "resources")
+		for _, k := range []string{"limits", "requests"} {
+			r := ensureMapping(resources, k)
+			if s.CPU != "" {
+				setScalar(r, "cpu", s.CPU, "!!str")
+			}
+			if s.Memory != "" {
+				setScalar(r, "memory", s.Memory, "!!str")
+			}
+		}
+	}
+}
+
+func (s *Settings) injectDockerCompose(root *yaml.Node) {
+	services := utils.MappingValue(root, "services")
+	if services == nil {
+		return
+	}
+
+	for i := 1; i < len(services.Content); i += 2 {
+		service := services.Content[i]
+
+		if env := s.env(); len(env) > 0 {
+			environment := utils.MappingValue(service, "environment")
+			if environment == nil {
+				environment = &yaml.Node{Kind: yaml.SequenceNode}
+				setValue(service, "environment", environment)
+			}
+			for _, e := range env {
+				if environment.Kind == yaml.MappingNode {
+					setScalar(environment, e[0], e[1], "!!str")
+					continue
+				}
+				setListEnvVar(environment, e[0], e[1])
+			}
+		}
+
+		if s.CPU != "" || s.Memory != "" {
+			limits := ensureMapping(ensureMapping(ensureMapping(service, "deploy"), "resources"), "limits")
+			if s.CPU != "" {
+				setScalar(limits, "cpus", s.CPU, "!!str")
+			}
+			if s.Memory != "" {
+				setScalar(limits, "memory", fmt.Sprintf("%dm", s.memoryMiB), "!!str")
+			}
+		}
+	}
+}
+
+func (s *Settings) injectHelmValues(root *yaml.Node) {
+	if s.ProxyHost != "" {
+		setScalar(root, "proxyHost", s.ProxyHost, "!!str")
+		setScalar(root, "proxyPort", s.ProxyPort, "!!str")
+		setScalar(root, "proxyScheme", s.ProxyScheme, "!!str")
+	}
+	if s.NoProxy != "" {
+		setScalar(root, "noProxy", s.NoProxy, "!!str")
+	}
+	if s.CPU != "" {
+		setScalar(root, "cpu", s.CPU, "")
+	}
+	if s.Memory != "" {
+		setScalar(root, "memory", strconv.Itoa(s.memoryMiB), "!!int")
+	}
+}
+
+// decodeDocuments decodes all the YAML documents in b, including the empty ones
+func decodeDocuments(b []byte) ([]*yaml.Node, error) {
+	var docs []*yaml.Node
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		doc := &yaml.Node{}
+		if err := dec.Decode(doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("unable to parse manifest, %w", err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// setValue sets or replaces the value of key in the mapping node n
+func setValue(n *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			n.Content[i+1] = value
+			return
+		}
+	}
+	n.Content = append(n.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+}
+
+// setScalar sets or replaces the scalar value of key in the mapping node n
+func setScalar(n *yaml.Node, key, value, tag string) {
+	setValue(n, key, &yaml.Node{Kind: yaml.ScalarNode, Value: value, Tag: tag})
+}
+
+// ensureMapping returns the mapping value of key from n, creating it if it does not exist
+func ensureMapping(n *yaml.Node, key string) *yaml.Node {
+	if v := utils.MappingValue(n, key); v != nil && v.Kind == yaml.MappingNode {
+		return v
+	}
+	v := &yaml.Node{Kind: yaml.MappingNode}
+	setValue(n, key, v)
+	return v
+}
+
+// setEnvVar sets or replaces the Kubernetes container environment variable in the sequence envs
+func setEnvVar(envs *yaml.Node, name, value string) {
+	for _, e := range envs.Content {
+		if n := utils.MappingValue(e, "name"); n != nil && n.Value == name {
+			setScalar(e, "value", value, "!!str")
+			return
+		}
+	}
+	e := &yaml.Node{Kind: yaml.MappingNode}
+	setScalar(e, "name", name, "")
+	setScalar(e, "value", value, "!!str")
+	envs.Content = append(envs.Content, e)
+}
+
+// setListEnvVar sets or replaces the environment variable in a docker compose list of KEY=value
+func setListEnvVar(envs *yaml.Node, name, value string) {
+	kv := fmt.Sprintf("%s=%s", name, value)
+	for _, e := range envs.Content {
+		if strings.HasPrefix(e.Value, name+"=") {
+			e.Value = kv
+			return
+		}
+	}
+	envs.Content = append(envs.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: kv})
+}
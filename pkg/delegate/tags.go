@@ -0,0 +1,257 @@
+package delegate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const groupTagsURL = "https://app.harness.io/gateway/ng/api/delegate-group-tags/{id}"
+
+type TagsOptions struct {
+	types.ScopeOptions
+	// The name or identifier of the delegate group
+	Name string
+	// The tags to add, remove or set
+	Tags []string
+	// "get", "add", "remove" or "set"
+	action string
+}
+
+// GroupTags gets or updates the tags of a delegate group
+type GroupTags struct {
+	// APIKey holds the API Key for the API calls
+	APIKey string `json:"-"`
+	// AccountID holds the AccountID that will be used for API calls
+	AccountID string `json:"-"`
+	// ProjectIdentifier the project identifier will attach the resource the project
+	ProjectIdentifier string `json:"-"`
+	// OrgID the organisation ID under which the project "ProjectIdentifier" resides
+	OrgID string `json:"-"`
+	// The scope of the resource "account", "org" or "project"
+	Scope string `json:"-"`
+	// The identifier of the delegate group
+	Identifier string `json:"-"`
+	// "get", "add" or "set"
+	Action string `json:"-"`
+	// The tags to add or set
+	Tags []string `json:"tags"`
+}
+
+// Call implements types.RESTCall
+func (gt *GroupTags) Call() (map[string]interface{}, error) {
+	req := utils.NewHTTPRequest(gt.APIKey, gt.AccountID)
+	utils.AddScopedIDQueryParams(req, gt.Scope, gt.OrgID, gt.ProjectIdentifier)
+	req.SetPathParam("id", gt.Identifier)
+
+	switch gt.Action {
+	case "add":
+		log.Infof("Adding tags %v to delegate %s", gt.Tags, gt.Identifier)
+		return utils.PostJSON(req, groupTagsURL, gt)
+	case "set":
+		log.Infof("Setting tags %v on delegate %s", gt.Tags, gt.Identifier)
+		return utils.PutJSON(req, groupTagsURL, gt)
+	default:
+		return utils.GetJSON(req, groupTagsURL)
+	}
+}
+
+// Print implements types.RESTCall
+func (gt *GroupTags) Print(rm map[string]interface{}, err error) {
+	if printError(rm, err) {
+		return
+	}
+
+	group, ok := rm["resource"].(map[string]interface{})
+	if !ok {
+		log.Errorf("%#v", rm)
+		return
+	}
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(map[string]interface{}{
+		"name": group["name"],
+		"id":   group["identifier"],
+		"tags": group["tags"],
+	})
+}
+
+// currentTags returns the current tags of the delegate group
+func (gt *GroupTags) currentTags() ([]string, error) {
+	get := *gt
+	get.Action = "get"
+	rm, err := get.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := rm["status"]; ok && v == "ERROR" {
+		return nil, fmt.Errorf("%s", rm["message"])
+	}
+
+	group, ok := rm["resource"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected response %#v", rm)
+	}
+
+	var tags []string
+	if v, ok := group["tags"].([]interface{}); ok {
+		for _, t := range v {
+			tags = append(tags, t.(string))
+		}
+	}
+
+	return tags, nil
+}
+
+// AddFlags implements types.Command
+func (to *TagsOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&to.Name, "name", "n", "", "The name or identifier of the delegate group.")
+	cmd.MarkFlagRequired("name")
+	if to.action != "get" {
+		cmd.Flags().StringSliceVarP(&to.Tags, "tags", "t", []string{}, fmt.Sprintf("The tags to %s.", to.action))
+		if to.action != "set" {
+			cmd.MarkFlagRequired("tags")
+		}
+	}
+	to.ScopeOptions.AddFlags(cmd, "delegate")
+}
+
+// Execute implements types.Command
+func (to *TagsOptions) Execute(cmd *cobra.Command, args []string) error {
+	gt := &GroupTags{
+		APIKey:    viper.GetString("api-key"),
+		AccountID: viper.GetString("account-id"),
+		Scope:     to.Scope,
+		Action:    to.action,
+		Tags:      to.Tags,
+	}
+
+	gt.OrgID, gt.ProjectIdentifier = to.ScopedIDs()
+
+	id, err := to.groupIdentifier(gt)
+	if err != nil {
+		return err
+	}
+	gt.Identifier = id
+
+	if to.action == "remove" {
+		current, err := gt.currentTags()
+		if err != nil {
+			return err
+		}
+		gt.Action = "set"
+		gt.Tags = without(current, to.Tags)
+	}
+
+	gt.Print(gt.Call())
+
+	return nil
+}
+
+// groupIdentifier resolves the identifier of the delegate group from its name
+func (to *TagsOptions) groupIdentifier(gt *GroupTags) (string, error) {
+	l := &List{
+		APIKey:            gt.APIKey,
+		AccountID:         gt.AccountID,
+		OrgID:             gt.OrgID,
+		ProjectIdentifier: gt.ProjectIdentifier,
+		Scope:             gt.Scope,
+		FilterType:        "Delegate",
+	}
+
+	groups, err := l.Groups()
+	if err != nil {
+		return "", err
+	}
+
+	for _, g := range groups {
+		if g.Name == to.Name || g.Identifier == to.Name {
+			return g.Identifier, nil
+		}
+	}
+
+	return "", fmt.Errorf(`delegate "%s" not found`, to.Name)
+}
+
+// without returns the tags that are not in remove
+func without(tags, remove []string) []string {
+	rm := make(map[string]bool, len(remove))
+	for _, t := range remove {
+		rm[t] = true
+	}
+
+	res := []string{}
+	for _, t := range tags {
+		if !rm[t] {
+			res = append(res, t)
+		}
+	}
+
+	return res
+}
+
+// Validate implements types.Command
+func (to *TagsOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var tagsCommandExamples = map[string]string{
+	"get": fmt.Sprintf(`
+# Get the tags of the delegate
+%[1]s delegate tags get --name my-delegate --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"add": fmt.Sprintf(`
+# Add the tags "gpu" and "linux" to the delegate
+%[1]s delegate tags add --name my-delegate --account-id <your account id> --project-id <project id> --tags gpu,linux
+`, common.ExamplePrefix()),
+	"remove": fmt.Sprintf(`
+# Remove the tag "gpu" from the account level delegate
+%[1]s delegate tags remove --name my-delegate --account-id <your account id> --delegate-scope account --tags gpu
+`, common.ExamplePrefix()),
+	"set": fmt.Sprintf(`
+# Replace all the tags of the delegate with "linux"
+%[1]s delegate tags set --name my-delegate --account-id <your account id> --project-id <project id> --tags linux
+`, common.ExamplePrefix()),
+}
+
+var tagsCommandShorts = map[string]string{
+	"get":    "Gets the tags of a delegate",
+	"add":    "Adds tags to a delegate",
+	"remove": "Removes tags from a delegate",
+	"set":    "Replaces all the tags of a delegate",
+}
+
+// newTagsCommands instantiates the new instance of the delegate tags commands
+func newTagsCommands() *cobra.Command {
+	tCmd := &cobra.Command{
+		Use:              "tags",
+		Short:            "Group of commands to manipulate the delegate tags.",
+		TraverseChildren: true,
+	}
+
+	for _, action := range []string{"get", "add", "remove", "set"} {
+		to := &TagsOptions{action: action}
+		aCmd := &cobra.Command{
+			Use:     action,
+			Short:   tagsCommandShorts[action],
+			Example: tagsCommandExamples[action],
+			RunE:    to.Execute,
+			PreRunE: to.Validate,
+		}
+		to.AddFlags(aCmd)
+		tCmd.AddCommand(aCmd)
+	}
+
+	return tCmd
+}
+
+var _ types.Command = (*TagsOptions)(nil)
+var _ types.RESTCall = (*GroupTags)(nil)
@@ -0,0 +1,464 @@
+package delegate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const tokenURL = "https://app.harness.io/gateway/ng/api/delegate-token-ng"
+
+type CreateTokenOptions struct {
+	types.ScopeOptions
+	// The name of the delegate token
+	Name string
+	// The duration after which the token will be revoked, zero means never
+	RevokeAfter time.Duration
+}
+
+type ListTokensOptions struct {
+	types.ScopeOptions
+	// The name of the delegate token to filter
+	Name string
+	// "ACTIVE" or "REVOKED"
+	Status string
+}
+
+type RevokeTokenOptions struct {
+	types.ScopeOptions
+	// The name of the delegate token
+	Name string
+}
+
+type TokenValueOptions struct {
+	types.ScopeOptions
+	// The name of the delegate token
+	Name string
+}
+
+// Token holds the common details required by the delegate token API calls
+type Token struct {
+	// APIKey holds the API Key for the API calls
+	APIKey string
+	// AccountID holds the AccountID that will be used for API calls
+	AccountID string
+	// ProjectIdentifier the project identifier will attach the resource the project
+	ProjectIdentifier string
+	// OrgID the organisation ID under which the project "ProjectIdentifier" resides
+	OrgID string
+	// The scope of the resource "account", "org" or "project"
+	Scope string
+	// The name of the delegate token
+	Name string
+}
+
+type CreateToken struct {
+	Token
+	// The time at which the token will be revoked, zero means never
+	RevokeAfter time.Time
+}
+
+type ListTokens struct {
+	Token
+	Status string
+}
+
+type RevokeToken struct {
+	Token
+}
+
+type TokenValue struct {
+	Token
+}
+
+// newToken builds the Token from the options and the global flags
+func newToken(so types.ScopeOptions, name string) Token {
+	t := Token{
+		APIKey:    viper.GetString("api-key"),
+		AccountID: viper.GetString("account-id"),
+		Scope:     so.Scope,
+		Name:      name,
+	}
+	t.OrgID, t.ProjectIdentifier = so.ScopedIDs()
+	return t
+}
+
+// request builds the HTTP request with the scope query params
+func (t *Token) request() *resty.Request {
+	req := utils.NewHTTPRequest(t.APIKey, t.AccountID)
+	utils.AddScopedIDQueryParams(req, t.Scope, t.OrgID, t.ProjectIdentifier)
+	return req
+}
+
+// printError prints the error from the response, returns true if there was an error
+func printError(rm map[string]interface{}, err error) bool {
+	if err != nil {
+		log.Errorf("%s", err)
+		return true
+	}
+
+	log.Tracef("%#v", rm)
+	if v, ok := rm["status"]; ok && v == "ERROR" {
+		fmt.Printf("%v\n", rm["message"])
+		return true
+	}
+
+	if _, ok := rm["resource"]; !ok {
+		log.Errorf("%#v", rm)
+		return true
+	}
+
+	return false
+}
+
+// failed returns true when the call returned an error or an error response
+func failed(rm map[string]interface{}, err error) bool {
+	if err != nil {
+		return true
+	}
+	_, ok := rm["resource"]
+	return !ok || rm["status"] == "ERROR"
+}
+
+// Call implements types.RESTCall
+func (ct *CreateToken) Call() (map[string]interface{}, error) {
+	req := ct.request()
+	req.SetQueryParam("tokenName", ct.Name)
+	if !ct.RevokeAfter.IsZero() {
+		req.SetQueryParam("revokeAfter", strconv.FormatInt(ct.RevokeAfter.UnixMilli(), 10))
+	}
+
+	log.Infof("Creating delegate token %s", ct.Name)
+
+	return utils.PostJSON(req, tokenURL, nil)
+}
+
+// Print implements types.RESTCall
+func (ct *CreateToken) Print(rm map[string]interface{}, err error) {
+	if printError(rm, err) {
+		return
+	}
+
+	token, ok := rm["resource"].(map[string]interface{})
+	if !ok {
+		log.Errorf("%#v", rm)
+		return
+	}
+	fmt.Println(token["value"])
+}
+
+// Call implements types.RESTCall
+func (lt *ListTokens) Call() (map[string]interface{}, error) {
+	req := lt.request()
+	if lt.Name != "" {
+		req.SetQueryParam("name", lt.Name)
+	}
+	if lt.Status != "" {
+		req.SetQueryParam("status", lt.Status)
+	}
+
+	return utils.GetJSON(req, tokenURL)
+}
+
+// Print implements types.RESTCall
+func (lt *ListTokens) Print(rm map[string]interface{}, err error) {
+	if printError(rm, err) {
+		return
+	}
+
+	tokens, ok := rm["resource"].([]interface{})
+	if !ok {
+		log.Errorf("%#v", rm)
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	for _, r := range tokens {
+		token, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		t := map[string]interface{}{
+			"name":   token["name"],
+			"status": token["status"],
+		}
+		if v, ok := token["createdAt"].(float64); ok {
+			t["createdAt"] = time.UnixMilli(int64(v)).Format(time.RFC3339)
+		}
+		if v, ok := token["revokeAfter"].(float64); ok && v > 0 {
+			t["revokeAfter"] = time.UnixMilli(int64(v)).Format(time.RFC3339)
+		}
+		if v, ok := token["createdBy"].(map[string]interface{}); ok {
+			t["createdBy"] = v["name"]
+		}
+		resMap = append(resMap, t)
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// Call implements types.RESTCall
+func (rt *RevokeToken) Call() (map[string]interface{}, error) {
+	req := rt.request()
+	req.SetQueryParam("tokenName", rt.Name)
+
+	log.Infof("Revoking delegate token %s", rt.Name)
+
+	return utils.PutJSON(req, tokenURL+"/revoke", nil)
+}
+
+// Print implements types.RESTCall
+func (rt *RevokeToken) Print(rm map[string]interface{}, err error) {
+	if printError(rm, err) {
+		return
+	}
+
+	fmt.Printf(`Delegate token "%s" revoked successfully`, rt.Name)
+}
+
+// Call implements types.RESTCall
+func (tv *TokenValue) Call() (map[string]interface{}, error) {
+	req := tv.request()
+	req.SetQueryParam("tokenName", tv.Name)
+
+	return utils.GetJSON(req, tokenURL+"/delegate-token-value")
+}
+
+// Print implements types.RESTCall
+func (tv *TokenValue) Print(rm map[string]interface{}, err error) {
+	if printError(rm, err) {
+		return
+	}
+
+	fmt.Println(rm["resource"])
+}
+
+// AddFlags implements types.Command
+func (co *CreateTokenOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the delegate token.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().DurationVarP(&co.RevokeAfter, "revoke-after", "", 0, "The duration after which the token is revoked e.g. 720h, by default the token is never revoked.")
+	co.ScopeOptions.AddFlags(cmd, "delegate")
+}
+
+// Execute implements types.Command
+func (co *CreateTokenOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	ct := &CreateToken{
+		Token: newToken(co.ScopeOptions, co.Name),
+	}
+
+	if co.RevokeAfter > 0 {
+		ct.RevokeAfter = time.Now().Add(co.RevokeAfter)
+	}
+
+	rm, err := ct.Call()
+	ct.Print(rm, err)
+	if failed(rm, err) {
+		return fmt.Errorf(`unable to create the delegate token "%s"`, ct.Name)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateTokenOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+// AddFlags implements types.Command
+func (lo *ListTokensOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Name, "name", "n", "", "The name of the delegate token to filter.")
+	cmd.Flags().StringVarP(&lo.Status, "status", "", "", `The status of the delegate tokens to filter. Valid value is one of "ACTIVE", "REVOKED"`)
+	lo.ScopeOptions.AddFlags(cmd, "delegate")
+}
+
+// Execute implements types.Command
+func (lo *ListTokensOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	lt := &ListTokens{
+		Token:  newToken(lo.ScopeOptions, lo.Name),
+		Status: lo.Status,
+	}
+
+	rm, err := lt.Call()
+	lt.Print(rm, err)
+	if failed(rm, err) {
+		return fmt.Errorf(`unable to list the delegate tokens`)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListTokensOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if lo.Status != "" && lo.Status != "ACTIVE" && lo.Status != "REVOKED" {
+		return fmt.Errorf(`"status" should be one of "ACTIVE" or "REVOKED"`)
+	}
+
+	return nil
+}
+
+// AddFlags implements types.Command
+func (ro *RevokeTokenOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&ro.Name, "name", "n", "", "The name of the delegate token to revoke.")
+	cmd.MarkFlagRequired("name")
+	ro.ScopeOptions.AddFlags(cmd, "delegate")
+}
+
+// Execute implements types.Command
+func (ro *RevokeTokenOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	rt := &RevokeToken{
+		Token: newToken(ro.ScopeOptions, ro.Name),
+	}
+
+	rm, err := rt.Call()
+	rt.Print(rm, err)
+	if failed(rm, err) {
+		return fmt.Errorf(`unable to revoke the delegate token "%s"`, rt.Name)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ro *RevokeTokenOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+// AddFlags implements types.Command
+func (vo *TokenValueOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&vo.Name, "name", "n", "", "The name of the delegate token.")
+	cmd.MarkFlagRequired("name")
+	vo.ScopeOptions.AddFlags(cmd, "delegate")
+}
+
+// Execute implements types.Command
+func (vo *TokenValueOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	tv := &TokenValue{
+		Token: newToken(vo.ScopeOptions, vo.Name),
+	}
+
+	rm, err := tv.Call()
+	tv.Print(rm, err)
+	if failed(rm, err) {
+		return fmt.Errorf(`unable to get the value of the delegate token "%s"`, tv.Name)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (vo *TokenValueOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var createTokenCommandExample = fmt.Sprintf(`
+# Create a delegate token for the cluster "prod-east"
+%[1]s delegate token create --name prod-east --account-id <your account id> --project-id <project id>
+# Create an account level delegate token that is revoked after 30 days
+%[1]s delegate token create --name prod-east --account-id <your account id> --delegate-scope account --revoke-after 720h
+`, common.ExamplePrefix())
+
+var listTokensCommandExample = fmt.Sprintf(`
+# List the active delegate tokens of the project
+%[1]s delegate token list --account-id <your account id> --project-id <project id> --status ACTIVE
+`, common.ExamplePrefix())
+
+var revokeTokenCommandExample = fmt.Sprintf(`
+# Revoke the delegate token when the cluster is decommissioned
+%[1]s delegate token revoke --name prod-east --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+var tokenValueCommandExample = fmt.Sprintf(`
+# Get the value of the delegate token
+%[1]s delegate token get-value --name prod-east --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newTokenCommands instantiates the new instance of the delegate token commands
+func newTokenCommands() *cobra.Command {
+	tCmd := &cobra.Command{
+		Use:              "token",
+		Short:            "Group of commands to manipulate the delegate tokens.",
+		TraverseChildren: true,
+	}
+
+	co := &CreateTokenOptions{}
+	ctCmd := &cobra.Command{
+		Use:     "create",
+		Short:   "Creates a new delegate token and prints its value",
+		Example: createTokenCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+	co.AddFlags(ctCmd)
+
+	lo := &ListTokensOptions{}
+	ltCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List the delegate tokens",
+		Example: listTokensCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+	lo.AddFlags(ltCmd)
+
+	ro := &RevokeTokenOptions{}
+	rtCmd := &cobra.Command{
+		Use:     "revoke",
+		Short:   "Revokes a delegate token",
+		Example: revokeTokenCommandExample,
+		RunE:    ro.Execute,
+		PreRunE: ro.Validate,
+	}
+	ro.AddFlags(rtCmd)
+
+	vo := &TokenValueOptions{}
+	vtCmd := &cobra.Command{
+		Use:     "get-value",
+		Short:   "Prints the value of a delegate token",
+		Example: tokenValueCommandExample,
+		RunE:    vo.Execute,
+		PreRunE: vo.Validate,
+	}
+	vo.AddFlags(vtCmd)
+
+	tCmd.AddCommand(ctCmd, ltCmd, rtCmd, vtCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*CreateTokenOptions)(nil)
+var _ types.Command = (*ListTokensOptions)(nil)
+var _ types.Command = (*RevokeTokenOptions)(nil)
+var _ types.Command = (*TokenValueOptions)(nil)
+var _ types.RESTCall = (*CreateToken)(nil)
+var _ types.RESTCall = (*ListTokens)(nil)
+var _ types.RESTCall = (*RevokeToken)(nil)
+var _ types.RESTCall = (*TokenValue)(nil)
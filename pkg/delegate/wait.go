@@ -0,0 +1,129 @@
+package delegate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type WaitOptions struct {
+	types.ScopeOptions
+	// The name or identifier of the delegate group to wait for
+	Name string
+	// The minimum number of connected instances
+	MinInstances int
+	// How long to wait for the delegate group to be ready
+	Timeout time.Duration
+	// How often to poll the delegate API
+	Interval time.Duration
+}
+
+// AddFlags implements types.Command
+func (wo *WaitOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&wo.Name, "name", "n", "", "The name or identifier of the delegate group to wait for.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().IntVarP(&wo.MinInstances, "min-instances", "", 1, "The minimum number of connected delegate instances.")
+	cmd.Flags().DurationVarP(&wo.Timeout, "timeout", "", 5*time.Minute, "How long to wait for the delegate to be ready.")
+	cmd.Flags().DurationVarP(&wo.Interval, "interval", "", 10*time.Second, "How often to check the delegate status.")
+	wo.ScopeOptions.AddFlags(cmd, "delegate")
+}
+
+// Execute implements types.Command
+func (wo *WaitOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	l := &List{
+		APIKey:     viper.GetString("api-key"),
+		AccountID:  viper.GetString("account-id"),
+		FilterType: "Delegate",
+		Scope:      wo.Scope,
+	}
+
+	l.OrgID, l.ProjectIdentifier = wo.ScopedIDs()
+
+	deadline := time.Now().Add(wo.Timeout)
+	for {
+		ready, err := wo.ready(l)
+		if err != nil {
+			log.Warnf("Unable to get delegate %s status, %s", wo.Name, err)
+		}
+		if ready {
+			fmt.Printf(`Delegate "%s" is ready`, wo.Name)
+			return nil
+		}
+
+		if time.Now().Add(wo.Interval).After(deadline) {
+			return fmt.Errorf(`timed out after %s waiting for delegate "%s" to have %d connected instance(s)`, wo.Timeout, wo.Name, wo.MinInstances)
+		}
+
+		time.Sleep(wo.Interval)
+	}
+}
+
+// ready checks if the delegate group is connected with the minimum number of instances
+func (wo *WaitOptions) ready(l *List) (bool, error) {
+	groups, err := l.Groups()
+	if err != nil {
+		return false, err
+	}
+
+	for _, g := range groups {
+		if g.Name != wo.Name && g.Identifier != wo.Name {
+			continue
+		}
+		connected := g.ConnectedInstances()
+		log.Infof("Delegate %s is %s with %d/%d connected instance(s)", wo.Name, g.Status(), connected, wo.MinInstances)
+		return g.Status() == "connected" && connected >= wo.MinInstances, nil
+	}
+
+	log.Infof("Delegate %s not found yet", wo.Name)
+
+	return false, nil
+}
+
+// Validate implements types.Command
+func (wo *WaitOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if wo.MinInstances < 1 {
+		return fmt.Errorf(`"min-instances" should be at least 1`)
+	}
+
+	if wo.Interval <= 0 || wo.Timeout <= 0 {
+		return fmt.Errorf(`"interval" and "timeout" should be greater than zero`)
+	}
+
+	return nil
+}
+
+var waitCommandExample = fmt.Sprintf(`
+# Wait up to 5 minutes for the delegate to be connected
+%[1]s delegate wait --name my-delegate --account-id <your account id> --project-id <project id>
+# Wait up to 10 minutes for the account level delegate to have 2 connected instances
+%[1]s delegate wait --name my-delegate --account-id <your account id> --delegate-scope account --min-instances 2 --timeout 10m
+`, common.ExamplePrefix())
+
+// newWaitCommand instantiates the new instance of the delegate wait command
+func newWaitCommand() *cobra.Command {
+	wo := &WaitOptions{}
+
+	wdCmd := &cobra.Command{
+		Use:     "wait",
+		Short:   "Waits until a delegate is connected with the required number of instances",
+		Example: waitCommandExample,
+		RunE:    wo.Execute,
+		PreRunE: wo.Validate,
+	}
+
+	wo.AddFlags(wdCmd)
+
+	return wdCmd
+}
+
+var _ types.Command = (*WaitOptions)(nil)
@@ -0,0 +1,149 @@
+package docker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	ecrURLPattern = regexp.MustCompile(`^\d{12}\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com(\.cn)?$`)
+	gcrURLPattern = regexp.MustCompile(`^((us|eu|asia)\.)?gcr\.io$`)
+	garURLPattern = regexp.MustCompile(`^([a-z0-9-]+)-docker\.pkg\.dev$`)
+	acrURLPattern = regexp.MustCompile(`^[a-z0-9]{5,50}\.azurecr\.io$`)
+)
+
+// cloudConnectorTypes maps the cloud registry provider to the Harness connector type
+// it must reference
+var cloudConnectorTypes = map[string]string{
+	"ECR": "Aws",
+	"GCR": "Gcp",
+	"GAR": "Gcp",
+	"ACR": "Azure",
+}
+
+// RegistryReference references an existing cloud connector for a cloud registry.
+// Harness does not have a dedicated connector type for ECR, GCR, GAR and ACR,
+// the registry connector is an AWS, GCP or Azure connector that reuses the
+// credentials of the referenced cloud connector
+type RegistryReference struct {
+	APIKey    string
+	AccountID string
+	// Name of the registry connector
+	Name string
+	// Identifier of the cloud connector
+	Identifier   string
+	OrgID        string
+	ProjectID    string
+	ProviderType string
+	Region       string
+	Scope        string
+	URL          string
+	// The delegate selectors of the registry connector, defaults to the ones of the cloud connector
+	DelegateSelectors []string
+}
+
+func isCloudProvider(providerType string) bool {
+	_, ok := cloudConnectorTypes[providerType]
+	return ok
+}
+
+// validateRegistryURL checks if the url is a valid registry host for the provider,
+// for "ECR" and "GAR" it returns the region of the registry
+func validateRegistryURL(providerType, url string) (string, error) {
+	host := registryHost(url)
+	switch providerType {
+	case "ECR":
+		if m := ecrURLPattern.FindStringSubmatch(host); m != nil {
+			return m[1], nil
+		}
+		return "", fmt.Errorf(`"registry-url" %q is not an ECR registry, expecting <aws account id>.dkr.ecr.<region>.amazonaws.com`, url)
+	case "GCR":
+		if gcrURLPattern.MatchString(host) {
+			return "", nil
+		}
+		return "", fmt.Errorf(`"registry-url" %q is not a GCR registry, expecting one of gcr.io, us.gcr.io, eu.gcr.io or asia.gcr.io`, url)
+	case "GAR":
+		if m := garURLPattern.FindStringSubmatch(host); m != nil {
+			return m[1], nil
+		}
+		return "", fmt.Errorf(`"registry-url" %q is not a Google Artifact Registry, expecting <region>-docker.pkg.dev`, url)
+	case "ACR":
+		if acrURLPattern.MatchString(host) {
+			return "", nil
+		}
+		return "", fmt.Errorf(`"registry-url" %q is not an Azure Container Registry, expecting <registry name>.azurecr.io`, url)
+	}
+	return "", nil
+}
+
+// registryHost strips the scheme and path from the registry url
+func registryHost(url string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(url, "https://"), "http://")
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
+
+// Call implements types.RESTCall
+func (rr *RegistryReference) Call() (map[string]interface{}, error) {
+	req := utils.NewHTTPRequest(rr.APIKey, rr.AccountID)
+	utils.AddScopedIDQueryParams(req, rr.Scope, rr.OrgID, rr.ProjectID)
+	log.Infof(`Looking up %s connector "%s" for %s registry`, cloudConnectorTypes[rr.ProviderType], rr.Identifier, rr.ProviderType)
+	return utils.GetResourceByID(req, "https://app.harness.io/gateway/ng/api/connectors/{id}", rr.Identifier)
+}
+
+// Connector builds the registry connector from the looked up cloud connector,
+// the credentials of the cloud connector are reused as is
+func (rr *RegistryReference) Connector(rm map[string]interface{}, err error) (*types.Connector, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("%#v", rm)
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return nil, fmt.Errorf(`unable to get the connector "%s": %v`, rr.Identifier, rm["message"])
+	}
+
+	data, _ := rm["data"].(map[string]interface{})
+	conn, _ := data["connector"].(map[string]interface{})
+	connectorType := cloudConnectorTypes[rr.ProviderType]
+	if t, _ := conn["type"].(string); t != connectorType {
+		return nil, fmt.Errorf(`connector "%s" is of type "%s", %s registry requires a "%s" connector`, rr.Identifier, t, rr.ProviderType, connectorType)
+	}
+	spec, ok := conn["spec"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf(`connector "%s" has no spec`, rr.Identifier)
+	}
+
+	if len(rr.DelegateSelectors) > 0 {
+		spec["delegateSelectors"] = rr.DelegateSelectors
+	}
+
+	host := registryHost(rr.URL)
+	tags := map[string]string{
+		"registry": host,
+	}
+	if rr.Region != "" {
+		tags["region"] = rr.Region
+	}
+
+	return &types.Connector{
+		APIKey:      rr.APIKey,
+		AccountID:   rr.AccountID,
+		Name:        rr.Name,
+		Identifier:  utils.IDFromName(rr.Name),
+		Description: fmt.Sprintf(`%s registry %s using the credentials of the %s connector "%s"`, rr.ProviderType, host, connectorType, rr.Identifier),
+		OrgID:       rr.OrgID,
+		ProjectID:   rr.ProjectID,
+		Tags:        tags,
+		Type:        connectorType,
+		Scope:       rr.Scope,
+		Spec:        spec,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/delegate"
 	"github.com/kameshsampath/harness-cli/pkg/types"
 	"github.com/kameshsampath/harness-cli/pkg/utils"
 	"github.com/spf13/cobra"
@@ -26,9 +27,14 @@ type CreateOptions struct {
 	Password           string
 	Scope              string
 	URL                string
-	// "DockerHub" "Harbor" "Quay" "Other"
+	// "DockerHub" "Harbor" "Quay" "Other" "ECR" "GCR" "GAR" "ACR"
 	ProviderType      string
 	DelegateSelectors []string
+	CheckDelegateTags bool
+	// The name of the existing AWS, GCP or Azure connector used by the "ECR" "GCR" "GAR" "ACR" providers
+	CloudConnector string
+	// The AWS region of the "ECR" registry
+	Region string
 }
 
 type UserNamePasswordAuth struct {
@@ -55,21 +61,62 @@ func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the connector.")
 	cmd.MarkFlagRequired("name")
 	cmd.Flags().StringVarP(&co.UserName, "username", "u", "", "The docker registry user name.")
-	cmd.MarkFlagRequired("username")
 	cmd.Flags().StringVarP(&co.Password, "password", "", "", "The ID docker registry password secret. Use scope for finer access e.g. account.dockerhubpassword, org.dockerhubpassword etc.,")
-	cmd.MarkFlagRequired("password")
 	cmd.Flags().StringVarP(&co.AuthenticationType, "auth-type", "", "password", "The authentication type valid values are 'password' or 'anonymous' ")
 	cmd.Flags().StringVarP(&co.URL, "registry-url", "", "https://registry.hub.docker.com/v2/", "The Docker Registry v2 URL e.g. https://registry.hub.docker.com/v2/")
 	cmd.MarkFlagRequired("url")
-	cmd.Flags().StringVarP(&co.ProviderType, "provider-type", "", "DockerHub", `The Docker Registry provider type. Valid values are "DockerHub" "Harbor" "Quay" "Other"`)
+	cmd.Flags().StringVarP(&co.ProviderType, "provider-type", "", "DockerHub", `The Docker Registry provider type. Valid values are "DockerHub" "Harbor" "Quay" "Other" "ECR" "GCR" "GAR" "ACR"`)
+	cmd.Flags().StringVarP(&co.CloudConnector, "cloud-connector", "", "", `The name of the existing AWS, GCP or Azure connector. Required when "provider-type" is one of "ECR" "GCR" "GAR" "ACR"`)
+	cmd.Flags().StringVarP(&co.Region, "region", "", "", `The AWS region of the registry. Used only when "provider-type" is "ECR", defaults to the region in "registry-url"`)
 	cmd.Flags().StringVarP(&co.ProjectID, "project-id", "p", "", `The project where the connector will be created.`)
 	cmd.Flags().StringVarP(&co.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
 	cmd.Flags().BoolVarP(&co.ExecuteOnDelegate, "execute-on-delegate", "", true, "Allow the connector to execute on delegate.")
 	cmd.Flags().StringSliceVarP(&co.DelegateSelectors, "delegate-tags", "", []string{}, `The delegate tags that will be used to select the available delegate that will be used by the connector.`)
+	cmd.Flags().BoolVarP(&co.CheckDelegateTags, "check-delegate-tags", "", false, "Warn when none of the delegate tags match an existing delegate.")
 }
 
 // Execute implements types.Command
 func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	if isCloudProvider(co.ProviderType) {
+		cmd.SilenceUsage = true
+
+		rr := &RegistryReference{
+			APIKey:            viper.GetString("api-key"),
+			AccountID:         viper.GetString("account-id"),
+			Name:              co.Name,
+			ProviderType:      co.ProviderType,
+			Identifier:        utils.IDFromName(co.CloudConnector),
+			Scope:             co.Scope,
+			URL:               co.URL,
+			Region:            co.Region,
+			DelegateSelectors: co.DelegateSelectors,
+		}
+
+		if co.Scope == "project" {
+			rr.OrgID = viper.GetString("org-id")
+			rr.ProjectID = co.ProjectID
+		} else if co.Scope == "org" {
+			rr.OrgID = viper.GetString("org-id")
+		}
+
+		c, err := rr.Connector(rr.Call())
+		if err != nil {
+			return err
+		}
+
+		if co.CheckDelegateTags {
+			delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, co.DelegateSelectors)
+		}
+
+		ci := &types.ConnectorInfo{
+			ConnectorInfo: *c,
+		}
+
+		ci.Call()
+
+		return nil
+	}
+
 	c := &types.Connector{
 		APIKey:     viper.GetString("api-key"),
 		AccountID:  viper.GetString("account-id"),
@@ -117,6 +164,10 @@ func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
 
 	c.Spec = spec
 
+	if co.CheckDelegateTags {
+		delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, co.DelegateSelectors)
+	}
+
 	ci := &types.ConnectorInfo{
 		ConnectorInfo: *c,
 	}
@@ -141,9 +192,29 @@ func scopedName(scope, name string) string {
 // Validate implements types.Command
 func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
 	viper.BindPFlags(cmd.Flags())
+
+	switch co.ProviderType {
+	case "DockerHub", "Harbor", "Quay", "Other":
+	case "ECR", "GCR", "GAR", "ACR":
+		if co.CloudConnector == "" {
+			return fmt.Errorf(`"cloud-connector" is required when "provider-type" is "%s"`, co.ProviderType)
+		}
+		region, err := validateRegistryURL(co.ProviderType, co.URL)
+		if err != nil {
+			return err
+		}
+		if co.ProviderType == "ECR" && co.Region != "" && co.Region != region {
+			return fmt.Errorf(`"region" %q does not match the region %q of "registry-url"`, co.Region, region)
+		}
+		co.Region = region
+		return nil
+	default:
+		return fmt.Errorf(`"provider-type" should be one of "DockerHub" "Harbor" "Quay" "Other" "ECR" "GCR" "GAR" "ACR"`)
+	}
+
 	authType := co.AuthenticationType
-	if authType == "Http" && (co.UserName == "" || co.Password == "") {
-		return fmt.Errorf(`"username" and "password" is required for Http authentication`)
+	if authType == "password" && (co.UserName == "" || co.Password == "") {
+		return fmt.Errorf(`"username" and "password" is required for password authentication`)
 	}
 	return nil
 }
@@ -158,6 +229,12 @@ var newCommandExample = fmt.Sprintf(`
 %[1]s docker-registry new --name foo --account-id <your account id> --username foo --password foo-password --connector-scope="account"
 # Create new docker registry connector with username and password at org scope, default is project
 %[1]s docker-registry new --name foo --account-id <your account id> --username foo --password foo-password  --org-id=<orgid> --connector-scope="org"
+# Create an Amazon ECR registry connector using the credentials of an existing AWS connector
+%[1]s docker-registry new --name foo --account-id <your account id> --project-id <project id> --provider-type ECR --cloud-connector my-aws --registry-url 123456789012.dkr.ecr.us-east-1.amazonaws.com
+# Create a Google Artifact Registry connector using the credentials of an existing GCP connector
+%[1]s docker-registry new --name foo --account-id <your account id> --project-id <project id> --provider-type GAR --cloud-connector my-gcp --registry-url us-central1-docker.pkg.dev
+# Create an Azure Container Registry connector using the credentials of an existing Azure connector
+%[1]s docker-registry new --name foo --account-id <your account id> --project-id <project id> --provider-type ACR --cloud-connector my-azure --registry-url myregistry.azurecr.io
 `, common.ExamplePrefix())
 
 // NewDockerConnectorCommand instantiates the new instance of the NewDockerConnectorCommand
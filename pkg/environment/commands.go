@@ -0,0 +1,42 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package environment
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewEnvironmentCommands is parent for all the "environment" resource commands
+func NewEnvironmentCommands() *cobra.Command {
+	eCmd := &cobra.Command{
+		Use:              "environment",
+		Aliases:          []string{"env"},
+		Short:            "Group of commands to manipulate the environments, environment groups and service overrides.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	eCmd.AddCommand(newCreateCommand())
+	eCmd.AddCommand(newGetCommand())
+	eCmd.AddCommand(newListCommand())
+	eCmd.AddCommand(newUpdateCommand())
+	eCmd.AddCommand(newDeleteCommand())
+	eCmd.AddCommand(newGroupCommands())
+	eCmd.AddCommand(newOverrideCommands())
+
+	return eCmd
+}
@@ -0,0 +1,88 @@
+package environment
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// DeleteEnvironment deletes the environment
+type DeleteEnvironment struct {
+	types.ScopedResource
+	// The identifier of the environment
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (de *DeleteEnvironment) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(de.Request(), environmentURL, de.Identifier)
+}
+
+// Print implements types.RESTCall
+func (de *DeleteEnvironment) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Environment \"%s\" deleted successfully\n", de.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the environment to delete.")
+	cmd.MarkFlagRequired("name")
+	do.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	de := &DeleteEnvironment{
+		ScopedResource: do.Resource(),
+		Identifier:     do.Name,
+	}
+
+	de.Print(de.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the environment
+%[1]s environment delete --name qa --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	eCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes an environment.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(eCmd)
+
+	return eCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteEnvironment)(nil)
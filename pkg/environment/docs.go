@@ -0,0 +1,6 @@
+package environment
+
+// environment package has the commands that will be used to manipulate the Harness environments
+// of type "PreProduction" or "Production" from their YAML, the environment groups and the
+// service overrides of the environments.
+// Refer to https://apidocs.harness.io/tag/Environments and https://apidocs.harness.io/tag/EnvironmentGroup for API
@@ -0,0 +1,91 @@
+package environment
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// GetEnvironment gets the environment YAML
+type GetEnvironment struct {
+	types.ScopedResource
+	// The identifier of the environment
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (ge *GetEnvironment) Call() (map[string]interface{}, error) {
+	return utils.GetResourceByID(ge.Request(), environmentURL, ge.Identifier)
+}
+
+// Print implements types.RESTCall
+func (ge *GetEnvironment) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	data := rm["data"].(map[string]interface{})
+	if e, ok := data["environment"].(map[string]interface{}); ok {
+		fmt.Print(e["yaml"])
+	}
+}
+
+// AddFlags implements types.Command
+func (geo *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&geo.Name, "name", "n", "", "The identifier of the environment.")
+	cmd.MarkFlagRequired("name")
+	geo.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (geo *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	ge := &GetEnvironment{
+		ScopedResource: geo.Resource(),
+		Identifier:     geo.Name,
+	}
+
+	ge.Print(ge.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (geo *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return geo.ScopeOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the environment YAML
+%[1]s environment get --name qa --account-id <your account id> --project-id <project id>
+# Get the YAML of an organization environment
+%[1]s environment get --name qa --account-id <your account id> --environment-scope org
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	geo := &GetOptions{}
+
+	eCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets the environment YAML.",
+		Example: getCommandExample,
+		RunE:    geo.Execute,
+		PreRunE: geo.Validate,
+	}
+
+	geo.AddFlags(eCmd)
+
+	return eCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetEnvironment)(nil)
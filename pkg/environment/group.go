@@ -0,0 +1,309 @@
+package environment
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	environmentGroupsURL    = "https://app.harness.io/gateway/ng/api/environmentGroup"
+	environmentGroupURL     = "https://app.harness.io/gateway/ng/api/environmentGroup/{id}"
+	environmentGroupListURL = "https://app.harness.io/gateway/ng/api/environmentGroup/list"
+)
+
+type GroupOptions struct {
+	types.ScopeOptions
+	// The environment group YAML file, used with "new" and "update"
+	File string
+	// The identifier of the environment group, used with "get" and "delete"
+	Name string
+	// "new", "get", "update" or "delete"
+	action string
+}
+
+type GroupListOptions struct {
+	types.ScopeOptions
+}
+
+// EnvironmentGroup creates or updates the environment group from its YAML
+type EnvironmentGroup struct {
+	types.ScopedResource
+	Identifier        string `json:"identifier"`
+	OrgIdentifier     string `json:"orgIdentifier,omitempty"`
+	ProjectIdentifier string `json:"projectIdentifier,omitempty"`
+	Color             string `json:"color,omitempty"`
+	// The environment group YAML
+	YAML string `json:"yaml"`
+	// whether to update the existing environment group
+	update bool
+}
+
+// GetEnvironmentGroup gets the environment group YAML
+type GetEnvironmentGroup struct {
+	types.ScopedResource
+	// The identifier of the environment group
+	Identifier string
+}
+
+// DeleteEnvironmentGroup deletes the environment group
+type DeleteEnvironmentGroup struct {
+	types.ScopedResource
+	// The identifier of the environment group
+	Identifier string
+}
+
+// ListEnvironmentGroups lists the environment groups of the scope
+type ListEnvironmentGroups struct {
+	types.ScopedResource
+	// Always "EnvironmentGroup"
+	FilterType string `json:"filterType"`
+}
+
+// Call implements types.RESTCall
+func (eg *EnvironmentGroup) Call() (map[string]interface{}, error) {
+	if eg.update {
+		req := eg.Request()
+		req.SetPathParam("id", eg.Identifier)
+		return utils.PutJSON(req, environmentGroupURL, eg)
+	}
+	return utils.PostJSON(eg.Request(), environmentGroupsURL, eg)
+}
+
+// Print implements types.RESTCall
+func (eg *EnvironmentGroup) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	action := "created"
+	if eg.update {
+		action = "updated"
+	}
+	fmt.Printf("Environment group \"%s\" %s successfully\n", eg.Identifier, action)
+}
+
+// Call implements types.RESTCall
+func (ge *GetEnvironmentGroup) Call() (map[string]interface{}, error) {
+	return utils.GetResourceByID(ge.Request(), environmentGroupURL, ge.Identifier)
+}
+
+// Print implements types.RESTCall
+func (ge *GetEnvironmentGroup) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	data := rm["data"].(map[string]interface{})
+	if eg, ok := data["envGroup"].(map[string]interface{}); ok {
+		fmt.Print(eg["yaml"])
+	}
+}
+
+// Call implements types.RESTCall
+func (de *DeleteEnvironmentGroup) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(de.Request(), environmentGroupURL, de.Identifier)
+}
+
+// Print implements types.RESTCall
+func (de *DeleteEnvironmentGroup) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Environment group \"%s\" deleted successfully\n", de.Identifier)
+}
+
+// Call implements types.RESTCall
+func (le *ListEnvironmentGroups) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := le.Request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		return utils.PostJSON(req, environmentGroupListURL, le)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (le *ListEnvironmentGroups) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			eg, ok := c.(map[string]interface{})["envGroup"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			resMap = append(resMap, map[string]interface{}{
+				"name":         eg["name"],
+				"id":           eg["identifier"],
+				"environments": eg["envIdentifiers"],
+				"tags":         eg["tags"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (gro *GroupOptions) AddFlags(cmd *cobra.Command) {
+	switch gro.action {
+	case "new", "update":
+		cmd.Flags().StringVarP(&gro.File, "file", "f", "", "The environment group YAML file.")
+		cmd.MarkFlagRequired("file")
+	default:
+		cmd.Flags().StringVarP(&gro.Name, "name", "n", "", "The identifier of the environment group.")
+		cmd.MarkFlagRequired("name")
+	}
+	gro.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (gro *GroupOptions) Execute(cmd *cobra.Command, args []string) error {
+	var rc types.RESTCall
+	switch gro.action {
+	case "new", "update":
+		b, eg, err := utils.ReadYAMLResource(gro.File, "environmentGroup")
+		if err != nil {
+			return err
+		}
+		r := gro.Resource()
+		rc = &EnvironmentGroup{
+			ScopedResource:    r,
+			Identifier:        utils.StringValue(eg, "identifier"),
+			OrgIdentifier:     r.OrgID,
+			ProjectIdentifier: r.ProjectID,
+			Color:             utils.StringValue(eg, "color"),
+			YAML:              string(b),
+			update:            gro.action == "update",
+		}
+	case "get":
+		rc = &GetEnvironmentGroup{
+			ScopedResource: gro.Resource(),
+			Identifier:     gro.Name,
+		}
+	default:
+		rc = &DeleteEnvironmentGroup{
+			ScopedResource: gro.Resource(),
+			Identifier:     gro.Name,
+		}
+	}
+
+	rc.Print(rc.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gro *GroupOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return gro.ScopeOptions.Validate()
+}
+
+// AddFlags implements types.Command
+func (glo *GroupListOptions) AddFlags(cmd *cobra.Command) {
+	glo.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (glo *GroupListOptions) Execute(cmd *cobra.Command, args []string) error {
+	le := &ListEnvironmentGroups{
+		ScopedResource: glo.Resource(),
+		FilterType:     "EnvironmentGroup",
+	}
+
+	le.Print(le.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (glo *GroupListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return glo.ScopeOptions.Validate()
+}
+
+var groupCommandExamples = map[string]string{
+	"new": fmt.Sprintf(`
+# Create the environment group from its YAML
+%[1]s environment group new --file envgroup.yaml --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"get": fmt.Sprintf(`
+# Get the environment group YAML
+%[1]s environment group get --name production --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"list": fmt.Sprintf(`
+# List the environment groups of the project
+%[1]s environment group list --account-id <your account id> --project-id <project id>
+# List the environment groups of the organization
+%[1]s environment group list --account-id <your account id> --environment-scope org
+`, common.ExamplePrefix()),
+	"update": fmt.Sprintf(`
+# Update the environment group from its YAML
+%[1]s environment group update --file envgroup.yaml --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"delete": fmt.Sprintf(`
+# Delete the environment group
+%[1]s environment group delete --name production --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+}
+
+var groupCommandShorts = map[string]string{
+	"new":    "Creates a new environment group from its YAML.",
+	"get":    "Gets the environment group YAML.",
+	"list":   "Lists the environment groups.",
+	"update": "Updates the environment group from its YAML.",
+	"delete": "Deletes an environment group.",
+}
+
+// newGroupCommands instantiates the "environment group" command with its sub commands
+func newGroupCommands() *cobra.Command {
+	gCmd := &cobra.Command{
+		Use:              "group",
+		Short:            "Group of commands to manipulate the environment groups.",
+		TraverseChildren: true,
+	}
+
+	for _, action := range []string{"new", "get", "update", "delete"} {
+		gro := &GroupOptions{action: action}
+		cmd := &cobra.Command{
+			Use:     action,
+			Short:   groupCommandShorts[action],
+			Example: groupCommandExamples[action],
+			RunE:    gro.Execute,
+			PreRunE: gro.Validate,
+		}
+		gro.AddFlags(cmd)
+		gCmd.AddCommand(cmd)
+	}
+
+	glo := &GroupListOptions{}
+	lCmd := &cobra.Command{
+		Use:     "list",
+		Short:   groupCommandShorts["list"],
+		Example: groupCommandExamples["list"],
+		RunE:    glo.Execute,
+		PreRunE: glo.Validate,
+	}
+	glo.AddFlags(lCmd)
+	gCmd.AddCommand(lCmd)
+
+	return gCmd
+}
+
+var _ types.Command = (*GroupOptions)(nil)
+var _ types.Command = (*GroupListOptions)(nil)
+var _ types.RESTCall = (*EnvironmentGroup)(nil)
+var _ types.RESTCall = (*GetEnvironmentGroup)(nil)
+var _ types.RESTCall = (*DeleteEnvironmentGroup)(nil)
+var _ types.RESTCall = (*ListEnvironmentGroups)(nil)
@@ -0,0 +1,120 @@
+package environment
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+	// The environment type that will be used to filter the environments
+	Type string
+}
+
+// ListEnvironments lists the environments of the scope
+type ListEnvironments struct {
+	types.ScopedResource
+	// The environment type that will be used to filter the environments
+	Type string
+}
+
+// Call implements types.RESTCall
+func (le *ListEnvironments) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := le.Request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		return utils.GetJSON(req, environmentsURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (le *ListEnvironments) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			e, ok := c.(map[string]interface{})["environment"].(map[string]interface{})
+			if !ok || (le.Type != "" && e["type"] != le.Type) {
+				continue
+			}
+			resMap = append(resMap, map[string]interface{}{
+				"name":        e["name"],
+				"id":          e["identifier"],
+				"type":        e["type"],
+				"description": e["description"],
+				"tags":        e["tags"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Type, "type", "t", "", `The environment type to filter the environments. Valid value is one of "PreProduction" or "Production"`)
+	lo.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	le := &ListEnvironments{
+		ScopedResource: lo.Resource(),
+		Type:           lo.Type,
+	}
+
+	le.Print(le.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	if lo.Type != "" && !isEnvironmentType(lo.Type) {
+		return fmt.Errorf(`"type" should be one of "PreProduction" or "Production"`)
+	}
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the environments of the project
+%[1]s environment list --account-id <your account id> --project-id <project id>
+# List the account environments
+%[1]s environment list --account-id <your account id> --environment-scope account
+# List the production environments of the project
+%[1]s environment list --account-id <your account id> --project-id <project id> --type Production
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	eCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the environments.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(eCmd)
+
+	return eCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListEnvironments)(nil)
@@ -0,0 +1,82 @@
+package environment
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	// The environment YAML file
+	File string
+}
+
+// Call implements types.RESTCall
+func (e *Environment) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(e.Request(), environmentsURL, e)
+}
+
+// Print implements types.RESTCall
+func (e *Environment) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Environment \"%s\" created successfully\n", e.Identifier)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", "The environment YAML file.")
+	cmd.MarkFlagRequired("file")
+	co.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	e, err := readEnvironment(co.Resource(), co.File)
+	if err != nil {
+		return err
+	}
+
+	e.Print(e.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return co.ScopeOptions.Validate()
+}
+
+var createCommandExample = fmt.Sprintf(`
+# Create the environment from its YAML
+%[1]s environment new --file environment.yaml --account-id <your account id> --project-id <project id>
+# Create the environment at the account scope
+%[1]s environment new --file environment.yaml --account-id <your account id> --environment-scope account
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	eCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new environment from its YAML.",
+		Example: createCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(eCmd)
+
+	return eCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*Environment)(nil)
@@ -0,0 +1,262 @@
+package environment
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const serviceOverridesURL = "https://app.harness.io/gateway/ng/api/environmentsV2/serviceOverrides"
+
+type OverrideOptions struct {
+	types.ScopeOptions
+	// The service overrides YAML file, used with "set"
+	File string
+	// The identifier of the environment, used with "list" and "delete"
+	Environment string
+	// The identifier of the service, used with "list" and "delete"
+	Service string
+	// "set", "list" or "delete"
+	action string
+}
+
+// ServiceOverride creates or updates the overrides of a service in an environment
+type ServiceOverride struct {
+	types.ScopedResource
+	OrgIdentifier         string `json:"orgIdentifier,omitempty"`
+	ProjectIdentifier     string `json:"projectIdentifier,omitempty"`
+	EnvironmentIdentifier string `json:"environmentIdentifier"`
+	ServiceIdentifier     string `json:"serviceIdentifier"`
+	// The service overrides YAML
+	YAML string `json:"yaml"`
+}
+
+// ListServiceOverrides lists the service overrides of an environment
+type ListServiceOverrides struct {
+	types.ScopedResource
+	EnvironmentIdentifier string
+	// optional, to list only the overrides of the service
+	ServiceIdentifier string
+}
+
+// DeleteServiceOverride deletes the overrides of a service in an environment
+type DeleteServiceOverride struct {
+	types.ScopedResource
+	EnvironmentIdentifier string
+	ServiceIdentifier     string
+}
+
+// Call implements types.RESTCall
+func (so *ServiceOverride) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(so.Request(), serviceOverridesURL, so)
+}
+
+// Print implements types.RESTCall
+func (so *ServiceOverride) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Overrides of service \"%s\" in environment \"%s\" saved successfully\n", so.ServiceIdentifier, so.EnvironmentIdentifier)
+}
+
+// Call implements types.RESTCall
+func (lo *ListServiceOverrides) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lo.Request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		req.SetQueryParam("environmentIdentifier", lo.EnvironmentIdentifier)
+		if lo.ServiceIdentifier != "" {
+			req.SetQueryParam("serviceIdentifier", lo.ServiceIdentifier)
+		}
+		return utils.GetJSON(req, serviceOverridesURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lo *ListServiceOverrides) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			o := c.(map[string]interface{})
+			resMap = append(resMap, map[string]interface{}{
+				"environment": o["environmentRef"],
+				"service":     o["serviceRef"],
+				"yaml":        o["yaml"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// Call implements types.RESTCall
+func (do *DeleteServiceOverride) Call() (map[string]interface{}, error) {
+	req := do.Request()
+	req.SetQueryParam("environmentIdentifier", do.EnvironmentIdentifier)
+	req.SetQueryParam("serviceIdentifier", do.ServiceIdentifier)
+	return utils.DeleteJSON(req, serviceOverridesURL)
+}
+
+// Print implements types.RESTCall
+func (do *DeleteServiceOverride) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Overrides of service \"%s\" in environment \"%s\" deleted successfully\n", do.ServiceIdentifier, do.EnvironmentIdentifier)
+}
+
+// readServiceOverride reads the service overrides YAML file, the overrides are identified
+// by the "environmentRef" and the "serviceRef" of the "serviceOverrides" root
+func readServiceOverride(r types.ScopedResource, file string) (*ServiceOverride, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc struct {
+		ServiceOverrides *struct {
+			EnvironmentRef string `yaml:"environmentRef"`
+			ServiceRef     string `yaml:"serviceRef"`
+		} `yaml:"serviceOverrides"`
+	}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	if doc.ServiceOverrides == nil {
+		return nil, fmt.Errorf(`"%s" is not a service overrides YAML, missing the "serviceOverrides" root`, file)
+	}
+	if doc.ServiceOverrides.EnvironmentRef == "" || doc.ServiceOverrides.ServiceRef == "" {
+		return nil, fmt.Errorf(`"%s" should have both "serviceOverrides.environmentRef" and "serviceOverrides.serviceRef"`, file)
+	}
+
+	return &ServiceOverride{
+		ScopedResource:        r,
+		OrgIdentifier:         r.OrgID,
+		ProjectIdentifier:     r.ProjectID,
+		EnvironmentIdentifier: doc.ServiceOverrides.EnvironmentRef,
+		ServiceIdentifier:     doc.ServiceOverrides.ServiceRef,
+		YAML:                  string(b),
+	}, nil
+}
+
+// AddFlags implements types.Command
+func (oo *OverrideOptions) AddFlags(cmd *cobra.Command) {
+	switch oo.action {
+	case "set":
+		cmd.Flags().StringVarP(&oo.File, "file", "f", "", `The service overrides YAML file, the overrides are identified by their "environmentRef" and "serviceRef".`)
+		cmd.MarkFlagRequired("file")
+	case "list":
+		cmd.Flags().StringVarP(&oo.Environment, "environment", "e", "", "The identifier of the environment.")
+		cmd.MarkFlagRequired("environment")
+		cmd.Flags().StringVarP(&oo.Service, "service", "s", "", "The identifier of the service to list only its overrides.")
+	default:
+		cmd.Flags().StringVarP(&oo.Environment, "environment", "e", "", "The identifier of the environment.")
+		cmd.MarkFlagRequired("environment")
+		cmd.Flags().StringVarP(&oo.Service, "service", "s", "", "The identifier of the service.")
+		cmd.MarkFlagRequired("service")
+	}
+	oo.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (oo *OverrideOptions) Execute(cmd *cobra.Command, args []string) error {
+	var rc types.RESTCall
+	switch oo.action {
+	case "set":
+		so, err := readServiceOverride(oo.Resource(), oo.File)
+		if err != nil {
+			return err
+		}
+		rc = so
+	case "list":
+		lo := &ListServiceOverrides{
+			ScopedResource:        oo.Resource(),
+			EnvironmentIdentifier: oo.Environment,
+			ServiceIdentifier:     oo.Service,
+		}
+		rc = lo
+	default:
+		rc = &DeleteServiceOverride{
+			ScopedResource:        oo.Resource(),
+			EnvironmentIdentifier: oo.Environment,
+			ServiceIdentifier:     oo.Service,
+		}
+	}
+
+	rc.Print(rc.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (oo *OverrideOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return oo.ScopeOptions.Validate()
+}
+
+var overrideCommandExamples = map[string]string{
+	"set": fmt.Sprintf(`
+# Create or update the overrides of a service in an environment from their YAML
+%[1]s environment override set --file overrides.yaml --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"list": fmt.Sprintf(`
+# List the service overrides of the environment
+%[1]s environment override list --environment qa --account-id <your account id> --project-id <project id>
+# List the overrides of the service in the environment
+%[1]s environment override list --environment qa --service my_service --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"delete": fmt.Sprintf(`
+# Delete the overrides of the service in the environment
+%[1]s environment override delete --environment qa --service my_service --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+}
+
+var overrideCommandShorts = map[string]string{
+	"set":    "Creates or updates the overrides of a service in an environment from their YAML.",
+	"list":   "Lists the service overrides of an environment.",
+	"delete": "Deletes the overrides of a service in an environment.",
+}
+
+// newOverrideCommands instantiates the "environment override" command with its sub commands
+func newOverrideCommands() *cobra.Command {
+	oCmd := &cobra.Command{
+		Use:              "override",
+		Short:            "Group of commands to manipulate the service overrides of the environments.",
+		TraverseChildren: true,
+	}
+
+	for _, action := range []string{"set", "list", "delete"} {
+		oo := &OverrideOptions{action: action}
+		cmd := &cobra.Command{
+			Use:     action,
+			Short:   overrideCommandShorts[action],
+			Example: overrideCommandExamples[action],
+			RunE:    oo.Execute,
+			PreRunE: oo.Validate,
+		}
+		oo.AddFlags(cmd)
+		oCmd.AddCommand(cmd)
+	}
+
+	return oCmd
+}
+
+var _ types.Command = (*OverrideOptions)(nil)
+var _ types.RESTCall = (*ServiceOverride)(nil)
+var _ types.RESTCall = (*ListServiceOverrides)(nil)
+var _ types.RESTCall = (*DeleteServiceOverride)(nil)
@@ -0,0 +1,65 @@
+package environment
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+)
+
+const (
+	environmentsURL = "https://app.harness.io/gateway/ng/api/environmentsV2"
+	environmentURL  = "https://app.harness.io/gateway/ng/api/environmentsV2/{id}"
+)
+
+// environmentTypes are the valid types of an environment
+var environmentTypes = []string{"PreProduction", "Production"}
+
+// Environment holds the environment definition that will be created or updated from its YAML
+type Environment struct {
+	types.ScopedResource
+	Identifier  string `json:"identifier"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// The environment type "PreProduction" or "Production"
+	Type              string            `json:"type"`
+	OrgIdentifier     string            `json:"orgIdentifier,omitempty"`
+	ProjectIdentifier string            `json:"projectIdentifier,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
+	// The environment YAML
+	YAML string `json:"yaml"`
+}
+
+// readEnvironment reads the environment YAML file and builds the Environment at the scope of the resource
+func readEnvironment(r types.ScopedResource, file string) (*Environment, error) {
+	b, e, err := utils.ReadYAMLResource(file, "environment")
+	if err != nil {
+		return nil, err
+	}
+
+	t := utils.StringValue(e, "type")
+	if !isEnvironmentType(t) {
+		return nil, fmt.Errorf(`"%s" has invalid "environment.type" "%s", should be one of "PreProduction" or "Production"`, file, t)
+	}
+
+	return &Environment{
+		ScopedResource:    r,
+		Identifier:        utils.StringValue(e, "identifier"),
+		Name:              utils.StringValue(e, "name"),
+		Description:       utils.StringValue(e, "description"),
+		Type:              t,
+		OrgIdentifier:     r.OrgID,
+		ProjectIdentifier: r.ProjectID,
+		Tags:              utils.TagsValue(e),
+		YAML:              string(b),
+	}, nil
+}
+
+func isEnvironmentType(t string) bool {
+	for _, et := range environmentTypes {
+		if et == t {
+			return true
+		}
+	}
+	return false
+}
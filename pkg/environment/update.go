@@ -0,0 +1,86 @@
+package environment
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type UpdateOptions struct {
+	types.ScopeOptions
+	// The environment YAML file
+	File string
+}
+
+// UpdateEnvironment updates the environment with its YAML
+type UpdateEnvironment struct {
+	*Environment
+}
+
+// Call implements types.RESTCall
+func (ue *UpdateEnvironment) Call() (map[string]interface{}, error) {
+	return utils.PutJSON(ue.Request(), environmentsURL, ue.Environment)
+}
+
+// Print implements types.RESTCall
+func (ue *UpdateEnvironment) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Environment \"%s\" updated successfully\n", ue.Identifier)
+}
+
+// AddFlags implements types.Command
+func (uo *UpdateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&uo.File, "file", "f", "", "The environment YAML file, the environment is identified by its identifier.")
+	cmd.MarkFlagRequired("file")
+	uo.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (uo *UpdateOptions) Execute(cmd *cobra.Command, args []string) error {
+	e, err := readEnvironment(uo.Resource(), uo.File)
+	if err != nil {
+		return err
+	}
+
+	ue := &UpdateEnvironment{e}
+	ue.Print(ue.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (uo *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return uo.ScopeOptions.Validate()
+}
+
+var updateCommandExample = fmt.Sprintf(`
+# Update the environment from its YAML
+%[1]s environment update --file environment.yaml --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newUpdateCommand instantiates the new instance of the newUpdateCommand
+func newUpdateCommand() *cobra.Command {
+	uo := &UpdateOptions{}
+
+	eCmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Updates the environment from its YAML.",
+		Example: updateCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(eCmd)
+
+	return eCmd
+}
+
+var _ types.Command = (*UpdateOptions)(nil)
+var _ types.RESTCall = (*UpdateEnvironment)(nil)
@@ -0,0 +1,40 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package execution
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewExecutionCommands is parent for all the "execution" resource commands
+func NewExecutionCommands() *cobra.Command {
+	eCmd := &cobra.Command{
+		Use:              "execution",
+		Aliases:          []string{"exec"},
+		Short:            "Group of commands to inspect and control the pipeline executions.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	eCmd.AddCommand(newListCommand())
+	eCmd.AddCommand(newGetCommand())
+	eCmd.AddCommand(newLogsCommand())
+	eCmd.AddCommand(newInterruptCommands()...)
+	eCmd.AddCommand(newRetryCommands()...)
+
+	return eCmd
+}
@@ -0,0 +1,4 @@
+package execution
+
+// execution package has the commands that will be used to inspect and control the Harness Pipeline executions.
+// Refer to https://apidocs.harness.io/tag/Pipeline-Execution-Details for API
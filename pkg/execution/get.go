@@ -0,0 +1,152 @@
+package execution
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const executionDetailsURL = "https://app.harness.io/gateway/pipeline/api/pipelines/execution/v2/{id}"
+
+type GetOptions struct {
+	types.ScopeOptions
+	OutputOptions
+	// The identifier of the execution
+	ID string
+}
+
+// GetExecution gets the execution with its stages and steps
+type GetExecution struct {
+	types.ScopedResource
+	// The output format "table" or "json"
+	Output string
+	// The identifier of the execution
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (ge *GetExecution) Call() (map[string]interface{}, error) {
+	req := ge.Request()
+	req.SetQueryParam("renderFullBottomGraph", "true")
+	return utils.GetResourceByID(req, executionDetailsURL, ge.Identifier)
+}
+
+// Print implements types.RESTCall
+func (ge *GetExecution) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	data, _ := rm["data"].(map[string]interface{})
+	summary, ok := data["pipelineExecutionSummary"].(map[string]interface{})
+	if !ok {
+		log.Errorf(`no summary in the response of the execution "%s"`, ge.Identifier)
+		return
+	}
+	steps := stepsFromGraph(data)
+
+	startTs, endTs := int64Value(summary["startTs"]), int64Value(summary["endTs"])
+	res := map[string]interface{}{
+		"id":        summary["planExecutionId"],
+		"pipeline":  summary["pipelineIdentifier"],
+		"status":    summary["status"],
+		"startTime": startTime(startTs),
+		"duration":  duration(startTs, endTs).String(),
+	}
+
+	stages := []map[string]interface{}{}
+	for _, s := range stagesFromLayout(summary) {
+		stageSteps := []map[string]interface{}{}
+		for _, st := range steps {
+			if st.Stage != s.Identifier {
+				continue
+			}
+			stageSteps = append(stageSteps, map[string]interface{}{
+				"id":       st.Identifier,
+				"name":     st.Name,
+				"type":     st.Type,
+				"status":   st.Status,
+				"duration": duration(st.StartTs, st.EndTs).String(),
+			})
+		}
+		stages = append(stages, map[string]interface{}{
+			"id":       s.Identifier,
+			"name":     s.Name,
+			"status":   s.Status,
+			"duration": duration(s.StartTs, s.EndTs).String(),
+			"steps":    stageSteps,
+		})
+	}
+	res["stages"] = stages
+
+	printOutput(ge.Output, res, func(w io.Writer) {
+		fmt.Fprintln(w, "STAGE/STEP\tSTATUS\tDURATION")
+		fmt.Fprintf(w, "%s\t%s\t%s\n", res["pipeline"], res["status"], res["duration"])
+		for _, s := range stages {
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", s["id"], s["status"], s["duration"])
+			for _, st := range s["steps"].([]map[string]interface{}) {
+				fmt.Fprintf(w, "    %s\t%s\t%s\n", st["id"], st["status"], st["duration"])
+			}
+		}
+	})
+}
+
+// AddFlags implements types.Command
+func (gpo *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gpo.ID, "id", "", "", "The identifier of the execution.")
+	cmd.MarkFlagRequired("id")
+	gpo.ScopeOptions.AddProjectFlags(cmd)
+	gpo.OutputOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (gpo *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	ge := &GetExecution{
+		ScopedResource: gpo.Resource(),
+		Output:         gpo.Output,
+		Identifier:     gpo.ID,
+	}
+
+	ge.Print(ge.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gpo *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return gpo.OutputOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Show the stages and steps of the execution with their status and duration
+%[1]s execution get --id <execution id> --account-id <your account id> --project-id <project id>
+# Show the execution as JSON
+%[1]s execution get --id <execution id> --account-id <your account id> --project-id <project id> --output json
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gpo := &GetOptions{}
+
+	gCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Shows the stages and steps of an execution with their status and duration.",
+		Example: getCommandExample,
+		RunE:    gpo.Execute,
+		PreRunE: gpo.Validate,
+	}
+
+	gpo.AddFlags(gCmd)
+
+	return gCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetExecution)(nil)
@@ -0,0 +1,160 @@
+package execution
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+)
+
+// Step is a step node of the execution graph
+type Step struct {
+	// The identifier of the stage of the step
+	Stage string
+	// The identifier of the step
+	Identifier string
+	Name       string
+	Type       string
+	Status     string
+	StartTs    int64
+	EndTs      int64
+	// The log keys of the step, one per command unit
+	LogKeys []string
+}
+
+// Matches returns true when the step is of the stage and is the step, empty stage or step matches all
+func (s *Step) Matches(stage, step string) bool {
+	return (stage == "" || s.Stage == stage) &&
+		(step == "" || s.Identifier == step || s.Name == step)
+}
+
+// Finished returns true when the step will not progress anymore
+func (s *Step) Finished() bool {
+	return pipeline.IsTerminalStatus(s.Status)
+}
+
+// graph returns the status of the execution with the steps of the execution graph ordered by their start
+func graph(r types.ScopedResource, executionID string) (string, []Step, error) {
+	data, err := pipeline.ExecutionSummary(r, executionID, true)
+	if err != nil {
+		return "", nil, err
+	}
+
+	summary, ok := data["pipelineExecutionSummary"].(map[string]interface{})
+	if !ok {
+		return "", nil, fmt.Errorf(`no summary in the response of the execution "%s"`, executionID)
+	}
+	status, _ := summary["status"].(string)
+
+	return status, stepsFromGraph(data), nil
+}
+
+// stepsFromGraph parses the steps from the nodes of the execution graph
+func stepsFromGraph(data map[string]interface{}) []Step {
+	steps := []Step{}
+	eg, ok := data["executionGraph"].(map[string]interface{})
+	if !ok {
+		return steps
+	}
+	nodeMap, _ := eg["nodeMap"].(map[string]interface{})
+
+	for _, v := range nodeMap {
+		n := v.(map[string]interface{})
+		fqn, _ := n["baseFqn"].(string)
+		logBaseKey, _ := n["logBaseKey"].(string)
+		if logBaseKey == "" || !strings.Contains(fqn, ".steps.") {
+			continue
+		}
+
+		s := Step{
+			Stage:   stageFromFqn(fqn),
+			StartTs: int64Value(n["startTs"]),
+			EndTs:   int64Value(n["endTs"]),
+		}
+		s.Identifier, _ = n["identifier"].(string)
+		s.Name, _ = n["name"].(string)
+		s.Type, _ = n["stepType"].(string)
+		s.Status, _ = n["status"].(string)
+
+		if units, ok := n["unitProgresses"].([]interface{}); ok && len(units) > 0 {
+			for _, u := range units {
+				if name, ok := u.(map[string]interface{})["unitName"].(string); ok {
+					s.LogKeys = append(s.LogKeys, logBaseKey+"-commandUnit:"+name)
+				}
+			}
+		} else {
+			s.LogKeys = []string{logBaseKey}
+		}
+
+		steps = append(steps, s)
+	}
+
+	sort.SliceStable(steps, func(i, j int) bool {
+		return started(steps[i].StartTs) < started(steps[j].StartTs)
+	})
+
+	return steps
+}
+
+// stageFromFqn returns the stage identifier from the fully qualified name of the node
+// e.g. "build" from "pipeline.stages.build.spec.execution.steps.run"
+func stageFromFqn(fqn string) string {
+	s := strings.TrimPrefix(fqn, "pipeline.stages.")
+	stage, _, _ := strings.Cut(s, ".")
+	return stage
+}
+
+// int64Value returns the JSON number as int64
+func int64Value(v interface{}) int64 {
+	if f, ok := v.(float64); ok {
+		return int64(f)
+	}
+	return 0
+}
+
+// Stage is a stage node of the execution layout
+type Stage struct {
+	// The identifier of the stage
+	Identifier string
+	Name       string
+	Status     string
+	StartTs    int64
+	EndTs      int64
+}
+
+// stagesFromLayout parses the stages from the layout nodes of the execution summary ordered by their start
+func stagesFromLayout(summary map[string]interface{}) []Stage {
+	stages := []Stage{}
+	layout, _ := summary["layoutNodeMap"].(map[string]interface{})
+	for _, v := range layout {
+		n := v.(map[string]interface{})
+		if t, _ := n["nodeType"].(string); t == "parallel" {
+			continue
+		}
+		s := Stage{
+			StartTs: int64Value(n["startTs"]),
+			EndTs:   int64Value(n["endTs"]),
+		}
+		s.Identifier, _ = n["nodeIdentifier"].(string)
+		s.Name, _ = n["name"].(string)
+		s.Status, _ = n["status"].(string)
+		stages = append(stages, s)
+	}
+
+	sort.SliceStable(stages, func(i, j int) bool {
+		return started(stages[i].StartTs) < started(stages[j].StartTs)
+	})
+
+	return stages
+}
+
+// started returns the start epoch milliseconds to order the nodes, the nodes not started yet goes last
+func started(startTs int64) int64 {
+	if startTs == 0 {
+		return math.MaxInt64
+	}
+	return startTs
+}
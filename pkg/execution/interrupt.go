@@ -0,0 +1,156 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const interruptURL = "https://app.harness.io/gateway/pipeline/api/pipeline/execute/interrupt/{id}"
+
+// interruptTypes maps the command to the Harness interrupt type
+var interruptTypes = map[string]string{
+	"abort":  "AbortAll",
+	"pause":  "Pause",
+	"resume": "Resume",
+}
+
+type InterruptOptions struct {
+	types.ScopeOptions
+	pipeline.WaitOptions
+	// The identifier of the execution
+	ID string
+	// "abort", "pause" or "resume"
+	action string
+}
+
+// Interrupt aborts, pauses or resumes the execution
+type Interrupt struct {
+	types.ScopedResource
+	// The identifier of the execution
+	Identifier string
+	// "AbortAll", "Pause" or "Resume"
+	Type string
+}
+
+// Call implements types.RESTCall
+func (i *Interrupt) Call() (map[string]interface{}, error) {
+	req := i.Request()
+	req.SetPathParam("id", i.Identifier)
+	req.SetQueryParam("interruptType", i.Type)
+	return utils.PutJSON(req, interruptURL, nil)
+}
+
+// Print implements types.RESTCall
+func (i *Interrupt) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Interrupt \"%s\" registered for execution \"%s\"\n", i.Type, i.Identifier)
+}
+
+// AddFlags implements types.Command
+func (ino *InterruptOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&ino.ID, "id", "", "", fmt.Sprintf("The identifier of the execution to %s.", ino.action))
+	cmd.MarkFlagRequired("id")
+	ino.ScopeOptions.AddProjectFlags(cmd)
+	ino.WaitOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (ino *InterruptOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	i := &Interrupt{
+		ScopedResource: ino.Resource(),
+		Identifier:     ino.ID,
+		Type:           interruptTypes[ino.action],
+	}
+
+	rm, err := i.Call()
+	i.Print(rm, err)
+	if err != nil || rm["status"] != "SUCCESS" {
+		return fmt.Errorf(`unable to %s the execution "%s"`, ino.action, ino.ID)
+	}
+
+	if !ino.Wait {
+		return nil
+	}
+
+	switch ino.action {
+	case "abort":
+		status, err := pipeline.WaitForStatus(i.ScopedResource, ino.ID, ino.Timeout, ino.Interval, pipeline.IsTerminalStatus)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Execution \"%s\" finished with status \"%s\"\n", ino.ID, status)
+	case "pause":
+		status, err := pipeline.WaitForStatus(i.ScopedResource, ino.ID, ino.Timeout, ino.Interval, func(status string) bool {
+			return status == "Paused" || pipeline.IsTerminalStatus(status)
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Execution \"%s\" is \"%s\"\n", ino.ID, status)
+	default:
+		return ino.WaitFor(i.ScopedResource, ino.ID)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ino *InterruptOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return ino.WaitOptions.Validate()
+}
+
+var interruptCommandExamples = map[string]string{
+	"abort": fmt.Sprintf(`
+# Abort the execution
+%[1]s execution abort --id <execution id> --account-id <your account id> --project-id <project id>
+# Abort the execution and wait for it to be aborted
+%[1]s execution abort --id <execution id> --account-id <your account id> --project-id <project id> --wait
+`, common.ExamplePrefix()),
+	"pause": fmt.Sprintf(`
+# Pause the execution and wait for it to be paused
+%[1]s execution pause --id <execution id> --account-id <your account id> --project-id <project id> --wait
+`, common.ExamplePrefix()),
+	"resume": fmt.Sprintf(`
+# Resume the paused execution and wait for it to finish
+%[1]s execution resume --id <execution id> --account-id <your account id> --project-id <project id> --wait
+`, common.ExamplePrefix()),
+}
+
+var interruptCommandShorts = map[string]string{
+	"abort":  "Aborts a running execution.",
+	"pause":  "Pauses a running execution.",
+	"resume": "Resumes a paused execution.",
+}
+
+// newInterruptCommands instantiates the new instances of the execution abort, pause and resume commands
+func newInterruptCommands() []*cobra.Command {
+	cmds := []*cobra.Command{}
+	for _, action := range []string{"abort", "pause", "resume"} {
+		ino := &InterruptOptions{action: action}
+		iCmd := &cobra.Command{
+			Use:     action,
+			Short:   interruptCommandShorts[action],
+			Example: interruptCommandExamples[action],
+			RunE:    ino.Execute,
+			PreRunE: ino.Validate,
+		}
+		ino.AddFlags(iCmd)
+		cmds = append(cmds, iCmd)
+	}
+	return cmds
+}
+
+var _ types.Command = (*InterruptOptions)(nil)
+var _ types.RESTCall = (*Interrupt)(nil)
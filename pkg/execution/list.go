@@ -0,0 +1,284 @@
+package execution
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const executionsURL = "https://app.harness.io/gateway/pipeline/api/pipelines/execution/summary"
+
+type ListOptions struct {
+	types.ScopeOptions
+	OutputOptions
+	// The identifier of the pipeline of the executions
+	Pipeline string
+	// The statuses of the executions e.g. "Success", "Failed", "Running"
+	Status []string
+	// The git branch of the executions
+	Branch string
+	// The executions started in the duration until now
+	Since time.Duration
+	// The executions started after
+	From string
+	// The executions started before
+	To string
+	// The user email, user name or trigger type e.g. "MANUAL", "WEBHOOK" that started the executions
+	TriggeredBy string
+	// The maximum number of executions to list
+	Limit int
+}
+
+// ListExecutions lists the executions of the project
+type ListExecutions struct {
+	types.ScopedResource
+	// The output format "table" or "json"
+	Output string `json:"-"`
+	// The identifier of the pipeline of the executions
+	PipelineID string `json:"-"`
+	// The git branch of the executions
+	Branch string `json:"-"`
+	// The user email, user name or trigger type that started the executions
+	TriggeredBy string `json:"-"`
+	// The maximum number of executions to list
+	Limit int `json:"-"`
+	// Always "PipelineExecution"
+	FilterType string `json:"filterType"`
+	// The statuses of the executions
+	Status []string `json:"status,omitempty"`
+	// The start time range of the executions
+	TimeRange *TimeRange `json:"timeRange,omitempty"`
+}
+
+// TimeRange is the range of the execution start time in epoch milliseconds
+type TimeRange struct {
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime"`
+}
+
+// Call implements types.RESTCall
+func (le *ListExecutions) Call() (map[string]interface{}, error) {
+	size := le.Limit
+	// the executions are filtered by "TriggeredBy" after listing, use bigger pages to find them
+	if le.TriggeredBy != "" && size < 100 {
+		size = 100
+	}
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := le.Request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", fmt.Sprint(size))
+		if le.PipelineID != "" {
+			req.SetQueryParam("pipelineIdentifier", le.PipelineID)
+		}
+		if le.Branch != "" {
+			req.SetQueryParam("branch", le.Branch)
+		}
+		return utils.PostJSON(req, executionsURL, le)
+	}, le.triggeredBy, le.Limit)
+}
+
+// triggeredBy checks if the execution was started by the "TriggeredBy" user or trigger type
+func (le *ListExecutions) triggeredBy(item interface{}) bool {
+	if le.TriggeredBy == "" {
+		return true
+	}
+	e, _ := item.(map[string]interface{})
+	triggerType, triggeredBy := trigger(e)
+	return strings.EqualFold(le.TriggeredBy, triggerType) || strings.EqualFold(le.TriggeredBy, triggeredBy)
+}
+
+// Print implements types.RESTCall
+func (le *ListExecutions) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	content, _ := data["content"].([]interface{})
+	for _, c := range content {
+		e := c.(map[string]interface{})
+		triggerType, triggeredBy := trigger(e)
+		startTs, endTs := int64Value(e["startTs"]), int64Value(e["endTs"])
+		resMap = append(resMap, map[string]interface{}{
+			"id":          e["planExecutionId"],
+			"pipeline":    e["pipelineIdentifier"],
+			"status":      e["status"],
+			"branch":      branch(e),
+			"triggerType": triggerType,
+			"triggeredBy": triggeredBy,
+			"startTime":   startTime(startTs),
+			"duration":    duration(startTs, endTs).String(),
+		})
+	}
+
+	printOutput(le.Output, resMap, func(w io.Writer) {
+		fmt.Fprintln(w, "ID\tPIPELINE\tSTATUS\tBRANCH\tTRIGGERED BY\tSTARTED\tDURATION")
+		for _, e := range resMap {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e["id"], e["pipeline"], e["status"], e["branch"], e["triggeredBy"], e["startTime"], e["duration"])
+		}
+	})
+}
+
+// trigger returns the trigger type and the email or name of the user or the trigger that started the execution
+func trigger(e map[string]interface{}) (string, string) {
+	ti, ok := e["executionTriggerInfo"].(map[string]interface{})
+	if !ok {
+		return "", ""
+	}
+	triggerType, _ := ti["triggerType"].(string)
+	tb, ok := ti["triggeredBy"].(map[string]interface{})
+	if !ok {
+		return triggerType, ""
+	}
+	if extra, ok := tb["extraInfo"].(map[string]interface{}); ok {
+		if email, ok := extra["email"].(string); ok && email != "" {
+			return triggerType, email
+		}
+	}
+	identifier, _ := tb["identifier"].(string)
+	return triggerType, identifier
+}
+
+// branch returns the git branch of the pipeline or the CI build of the execution
+func branch(e map[string]interface{}) string {
+	if gd, ok := e["gitDetails"].(map[string]interface{}); ok {
+		if b, ok := gd["branch"].(string); ok && b != "" {
+			return b
+		}
+	}
+	if mi, ok := e["moduleInfo"].(map[string]interface{}); ok {
+		if ci, ok := mi["ci"].(map[string]interface{}); ok {
+			if b, ok := ci["branch"].(string); ok {
+				return b
+			}
+		}
+	}
+	return ""
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Pipeline, "pipeline", "", "", "The identifier of the pipeline to list the executions of.")
+	cmd.Flags().StringSliceVarP(&lo.Status, "status", "s", []string{}, `The statuses of the executions e.g. "Success", "Failed", "Running", "Aborted"`)
+	cmd.Flags().StringVarP(&lo.Branch, "branch", "b", "", "The git branch of the executions.")
+	cmd.Flags().DurationVarP(&lo.Since, "since", "", 0, "List the executions started in the duration until now e.g. 24h.")
+	cmd.Flags().StringVarP(&lo.From, "from", "", "", "List the executions started after the time in RFC3339 format e.g. 2022-10-01T00:00:00Z.")
+	cmd.Flags().StringVarP(&lo.To, "to", "", "", "List the executions started before the time in RFC3339 format e.g. 2022-10-31T00:00:00Z.")
+	cmd.Flags().StringVarP(&lo.TriggeredBy, "triggered-by", "", "", `The email or name of the user or the trigger type e.g. "MANUAL", "WEBHOOK", "SCHEDULER_CRON" that started the executions.`)
+	cmd.Flags().IntVarP(&lo.Limit, "limit", "l", 20, "The maximum number of executions to list.")
+	lo.ScopeOptions.AddProjectFlags(cmd)
+	lo.OutputOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	le := &ListExecutions{
+		ScopedResource: lo.Resource(),
+		Output:         lo.Output,
+		Branch:         lo.Branch,
+		TriggeredBy:    lo.TriggeredBy,
+		Limit:          lo.Limit,
+		FilterType:     "PipelineExecution",
+		Status:         lo.Status,
+		PipelineID:     lo.Pipeline,
+	}
+
+	tr, err := lo.timeRange()
+	if err != nil {
+		return err
+	}
+	le.TimeRange = tr
+
+	le.Print(le.Call())
+
+	return nil
+}
+
+// timeRange returns the time range from the "since", "from" and "to" flags, nil when none is set
+func (lo *ListOptions) timeRange() (*TimeRange, error) {
+	if lo.Since == 0 && lo.From == "" && lo.To == "" {
+		return nil, nil
+	}
+
+	tr := &TimeRange{
+		EndTime: time.Now().UnixMilli(),
+	}
+
+	if lo.Since > 0 {
+		tr.StartTime = time.Now().Add(-lo.Since).UnixMilli()
+	}
+
+	if lo.From != "" {
+		from, err := time.Parse(time.RFC3339, lo.From)
+		if err != nil {
+			return nil, fmt.Errorf(`"from" should be in RFC3339 format, %s`, err)
+		}
+		tr.StartTime = from.UnixMilli()
+	}
+
+	if lo.To != "" {
+		to, err := time.Parse(time.RFC3339, lo.To)
+		if err != nil {
+			return nil, fmt.Errorf(`"to" should be in RFC3339 format, %s`, err)
+		}
+		tr.EndTime = to.UnixMilli()
+	}
+
+	return tr, nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if lo.Since > 0 && lo.From != "" {
+		return fmt.Errorf(`"since" and "from" can not be used together`)
+	}
+
+	if _, err := lo.timeRange(); err != nil {
+		return err
+	}
+
+	if lo.Limit < 1 {
+		return fmt.Errorf(`"limit" should be at least 1`)
+	}
+
+	return lo.OutputOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the recent executions of the project
+%[1]s execution list --account-id <your account id> --project-id <project id>
+# List the failed executions of the pipeline in the last 24 hours
+%[1]s execution list --account-id <your account id> --project-id <project id> --pipeline my_pipeline --status Failed --since 24h
+# List the executions of the branch "main" started by webhooks as JSON
+%[1]s execution list --account-id <your account id> --project-id <project id> --branch main --triggered-by WEBHOOK --output json
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	lCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the pipeline executions of the project.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(lCmd)
+
+	return lCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListExecutions)(nil)
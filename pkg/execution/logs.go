@@ -0,0 +1,256 @@
+package execution
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type LogsOptions struct {
+	types.ScopeOptions
+	// The identifier of the execution
+	ID string
+	// The identifier of the stage to show the logs
+	Stage string
+	// The identifier or name of the step to show the logs
+	Step string
+	// Follow the logs until the execution finishes
+	Follow bool
+	// How often to check for the new steps when following the logs
+	Interval time.Duration
+	// The zip file or directory to download the logs to
+	Download string
+}
+
+// AddFlags implements types.Command
+func (lo *LogsOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.ID, "id", "", "", "The identifier of the execution.")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringVarP(&lo.Stage, "stage", "", "", "The identifier of the stage to show the logs of.")
+	cmd.Flags().StringVarP(&lo.Step, "step", "", "", "The identifier or name of the step to show the logs of.")
+	cmd.Flags().BoolVarP(&lo.Follow, "follow", "f", false, "Follow the logs until the execution finishes.")
+	cmd.Flags().DurationVarP(&lo.Interval, "interval", "", 5*time.Second, `How often to check for new steps. Used only with "follow"`)
+	cmd.Flags().StringVarP(&lo.Download, "download", "d", "", `Download the logs to a zip file when it ends with ".zip" or else to a directory, with one file per step.`)
+	lo.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (lo *LogsOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	r := lo.Resource()
+	ls, err := newLogService(r.APIKey, r.AccountID)
+	if err != nil {
+		return err
+	}
+
+	if lo.Follow {
+		return lo.follow(r, ls)
+	}
+
+	_, steps, err := graph(r, lo.ID)
+	if err != nil {
+		return err
+	}
+
+	if lo.Download != "" {
+		return lo.download(ls, steps)
+	}
+
+	for _, s := range lo.matching(steps) {
+		lo.printStep(ls, s, map[string]bool{})
+	}
+
+	return nil
+}
+
+// matching returns the steps matching the "stage" and "step" that have logs
+func (lo *LogsOptions) matching(steps []Step) []Step {
+	res := []Step{}
+	for _, s := range steps {
+		if s.Status == "NotStarted" || s.Status == "Skipped" || !s.Matches(lo.Stage, lo.Step) {
+			continue
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+// printStep prints the logs of the finished step, skipping the log keys already streamed
+func (lo *LogsOptions) printStep(ls *logService, s Step, streamed map[string]bool) {
+	for _, key := range s.LogKeys {
+		if streamed[key] {
+			continue
+		}
+		lines, err := ls.blob(key)
+		if err != nil {
+			log.Warnf("Unable to get the logs of step %s/%s, %s", s.Stage, s.Identifier, err)
+			continue
+		}
+		for _, l := range lines {
+			printLine(s, l)
+		}
+		streamed[key] = true
+	}
+}
+
+// follow prints the logs of the steps as they run until the execution finishes
+func (lo *LogsOptions) follow(r types.ScopedResource, ls *logService) error {
+	// the finished steps and the log keys already printed
+	printed := map[string]bool{}
+	streamed := map[string]bool{}
+	for {
+		status, steps, err := graph(r, lo.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range lo.matching(steps) {
+			id := s.Stage + "/" + s.Identifier
+			if printed[id] {
+				continue
+			}
+			if s.Finished() {
+				lo.printStep(ls, s, streamed)
+				printed[id] = true
+				continue
+			}
+			for _, key := range s.LogKeys {
+				if streamed[key] {
+					continue
+				}
+				if err := ls.stream(key, func(l string) { printLine(s, l) }); err != nil {
+					log.Debugf("Unable to stream the logs of step %s, %s", id, err)
+					break
+				}
+				streamed[key] = true
+			}
+		}
+
+		if pipeline.IsTerminalStatus(status) {
+			return nil
+		}
+
+		time.Sleep(lo.Interval)
+	}
+}
+
+// printLine prints the log line prefixed with the stage and the step
+func printLine(s Step, line string) {
+	fmt.Printf("[%s/%s] %s\n", s.Stage, s.Identifier, line)
+}
+
+// download writes the logs of the steps, one file per step, to a zip file or a directory
+func (lo *LogsOptions) download(ls *logService, steps []Step) error {
+	var zw *zip.Writer
+	if strings.HasSuffix(lo.Download, ".zip") {
+		f, err := os.Create(lo.Download)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		zw = zip.NewWriter(f)
+	}
+
+	for _, s := range lo.matching(steps) {
+		var buf bytes.Buffer
+		for _, key := range s.LogKeys {
+			lines, err := ls.blob(key)
+			if err != nil {
+				log.Warnf("Unable to get the logs of step %s/%s, %s", s.Stage, s.Identifier, err)
+				continue
+			}
+			for _, l := range lines {
+				fmt.Fprintln(&buf, l)
+			}
+		}
+
+		name := filepath.Join(s.Stage, s.Identifier+".log")
+		if zw != nil {
+			w, err := zw.Create(filepath.ToSlash(name))
+			if err != nil {
+				return err
+			}
+			if _, err := w.Write(buf.Bytes()); err != nil {
+				return err
+			}
+		} else {
+			name = filepath.Join(lo.Download, name)
+			if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+				return err
+			}
+			if err := os.WriteFile(name, buf.Bytes(), 0o644); err != nil {
+				return err
+			}
+		}
+		log.Infof("Downloaded the logs of step %s/%s", s.Stage, s.Identifier)
+	}
+
+	if zw != nil {
+		// closing writes the zip central directory, without it the archive is truncated
+		if err := zw.Close(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Logs of execution \"%s\" downloaded to \"%s\"\n", lo.ID, lo.Download)
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *LogsOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if lo.Follow && lo.Download != "" {
+		return fmt.Errorf(`"follow" and "download" can not be used together`)
+	}
+
+	if lo.Follow && lo.Interval <= 0 {
+		return fmt.Errorf(`"interval" should be greater than zero`)
+	}
+
+	return nil
+}
+
+var logsCommandExample = fmt.Sprintf(`
+# Show the logs of all the steps of the execution
+%[1]s execution logs --id <execution id> --account-id <your account id> --project-id <project id>
+# Show the logs of the step "run_tests" of the stage "build"
+%[1]s execution logs --id <execution id> --account-id <your account id> --project-id <project id> --stage build --step run_tests
+# Follow the logs of a running execution
+%[1]s execution logs --id <execution id> --account-id <your account id> --project-id <project id> --follow
+# Download the logs of all the steps to a zip file
+%[1]s execution logs --id <execution id> --account-id <your account id> --project-id <project id> --download logs.zip
+`, common.ExamplePrefix())
+
+// newLogsCommand instantiates the new instance of the newLogsCommand
+func newLogsCommand() *cobra.Command {
+	lo := &LogsOptions{}
+
+	lCmd := &cobra.Command{
+		Use:     "logs",
+		Short:   "Shows or downloads the step logs of an execution.",
+		Example: logsCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(lCmd)
+
+	return lCmd
+}
+
+var _ types.Command = (*LogsOptions)(nil)
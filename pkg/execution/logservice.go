@@ -0,0 +1,134 @@
+package execution
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	logTokenURL  = "https://app.harness.io/gateway/log-service/token"
+	logBlobURL   = "https://app.harness.io/gateway/log-service/blob"
+	logStreamURL = "https://app.harness.io/gateway/log-service/stream"
+)
+
+// logService reads the step logs from the Harness log service
+type logService struct {
+	apiKey    string
+	accountID string
+	// the log service token
+	token string
+}
+
+// logLine is a line of the step log
+type logLine struct {
+	Level string `json:"level"`
+	Out   string `json:"out"`
+}
+
+// newLogService gets the log service token of the account and returns the logService
+func newLogService(apiKey, accountID string) (*logService, error) {
+	ls := &logService{
+		apiKey:    apiKey,
+		accountID: accountID,
+	}
+
+	b, err := utils.GetContent(ls.request(), logTokenURL)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the log service token, %s", err)
+	}
+	ls.token = strings.TrimSpace(string(b))
+
+	return ls, nil
+}
+
+// request builds the log service request
+func (ls *logService) request() *resty.Request {
+	req := utils.NewHTTPRequest(ls.apiKey, ls.accountID)
+	req.SetQueryParam("accountID", ls.accountID)
+	if ls.token != "" {
+		req.SetHeader("X-Harness-Token", ls.token)
+	}
+	return req
+}
+
+// blob returns the log lines of the finished step log with key
+func (ls *logService) blob(key string) ([]string, error) {
+	req := ls.request()
+	req.SetQueryParam("key", key)
+
+	b, err := utils.GetContent(req, logBlobURL)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []string{}
+	s := bufio.NewScanner(bytes.NewReader(b))
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		if l, ok := parseLogLine(s.Bytes()); ok {
+			lines = append(lines, l)
+		}
+	}
+
+	return lines, s.Err()
+}
+
+// stream calls fn with each log line of the running step log with key until the log is closed
+func (ls *logService) stream(key string, fn func(line string)) error {
+	req := ls.request()
+	req.SetQueryParam("key", key)
+	req.SetHeader("Accept", "text/event-stream")
+	req.SetDoNotParseResponse(true)
+
+	resp, err := req.Get(logStreamURL)
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer body.Close()
+
+	if resp.IsError() {
+		b, _ := io.ReadAll(body)
+		return fmt.Errorf("%s %s", resp.Status(), b)
+	}
+
+	s := bufio.NewScanner(body)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	for s.Scan() {
+		if !strings.HasPrefix(s.Text(), "data:") {
+			continue
+		}
+		data := strings.TrimSpace(strings.TrimPrefix(s.Text(), "data:"))
+		if data == "eof" {
+			return nil
+		}
+		if l, ok := parseLogLine([]byte(data)); ok {
+			fn(l)
+		}
+	}
+
+	return s.Err()
+}
+
+// parseLogLine returns the output of the JSON log line
+func parseLogLine(b []byte) (string, bool) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return "", false
+	}
+
+	var l logLine
+	if err := json.Unmarshal(b, &l); err != nil {
+		log.Tracef("Unable to parse log line %s, %s", b, err)
+		return string(b), true
+	}
+
+	return strings.TrimRight(l.Out, "\r\n"), true
+}
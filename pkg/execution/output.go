@@ -0,0 +1,67 @@
+package execution
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// OutputOptions holds the flag to choose the output format
+type OutputOptions struct {
+	// "table" or "json"
+	Output string
+}
+
+// AddFlags adds the "output" flag to the command
+func (oo *OutputOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&oo.Output, "output", "", "table", `The output format. Valid values are "table" or "json"`)
+}
+
+// Validate checks the output format
+func (oo *OutputOptions) Validate() error {
+	if oo.Output != "table" && oo.Output != "json" {
+		return fmt.Errorf(`"output" should be one of "table" or "json"`)
+	}
+	return nil
+}
+
+// printOutput prints v as JSON when output is "json" or else calls table
+// with a tab writer to print it as a table
+func printOutput(output string, v interface{}, table func(w io.Writer)) {
+	if output == "json" {
+		en := json.NewEncoder(os.Stdout)
+		en.SetIndent("", "  ")
+		en.Encode(v)
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	table(w)
+	w.Flush()
+}
+
+// duration returns the duration between the start and the end epoch milliseconds,
+// when not ended the duration until now
+func duration(startTs, endTs int64) time.Duration {
+	if startTs <= 0 {
+		return 0
+	}
+	end := time.Now()
+	if endTs > 0 {
+		end = time.UnixMilli(endTs)
+	}
+	return end.Sub(time.UnixMilli(startTs)).Round(time.Second)
+}
+
+// startTime formats the start epoch milliseconds
+func startTime(startTs int64) string {
+	if startTs <= 0 {
+		return "-"
+	}
+	return time.UnixMilli(startTs).Format(time.RFC3339)
+}
@@ -0,0 +1,189 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	retryURL          = "https://app.harness.io/gateway/pipeline/api/pipeline/execute/retry/{pipelineId}"
+	rerunURL          = "https://app.harness.io/gateway/pipeline/api/pipeline/execute/rerun/{id}/{pipelineId}"
+	executionInputURL = "https://app.harness.io/gateway/pipeline/api/pipelines/execution/{id}/inputset"
+)
+
+type RetryOptions struct {
+	types.ScopeOptions
+	pipeline.WaitOptions
+	// The identifier of the execution
+	ID string
+	// The identifier of the stage to retry the execution from
+	FromStage string
+	// "retry" or "rerun"
+	action string
+}
+
+// Retry retries the execution from a stage or re-runs the whole execution with the same inputs
+type Retry struct {
+	types.ScopedResource
+	// "retry" or "rerun"
+	Action string
+	// The identifier of the execution to retry or re-run
+	Identifier string
+	// The identifier of the pipeline of the execution
+	PipelineID string
+	// The module of the pipeline of the execution
+	Module string
+	// The identifier of the stage to retry the execution from
+	FromStage string
+	// The runtime inputs YAML of the execution
+	InputsYAML []byte
+	// The identifier of the new execution
+	ExecutionID string
+}
+
+// Call implements types.RESTCall
+func (rt *Retry) Call() (map[string]interface{}, error) {
+	req := rt.Request()
+	req.SetPathParams(map[string]string{
+		"id":         rt.Identifier,
+		"pipelineId": rt.PipelineID,
+	})
+	req.SetQueryParam("moduleType", rt.Module)
+
+	if rt.Action == "retry" {
+		req.SetQueryParam("planExecutionId", rt.Identifier)
+		req.SetQueryParam("retryStages", rt.FromStage)
+		req.SetQueryParam("runAllStages", "true")
+		return utils.PostYAML(req, retryURL, rt.InputsYAML)
+	}
+
+	return utils.PostYAML(req, rerunURL, rt.InputsYAML)
+}
+
+// Print implements types.RESTCall
+func (rt *Retry) Print(rm map[string]interface{}, err error) {
+	rt.ExecutionID = pipeline.PrintExecution(rm, err, rt.ScopedResource, rt.Module, rt.PipelineID)
+}
+
+// load sets the pipeline, the module and the runtime inputs of the execution to retry or re-run
+func (rt *Retry) load() error {
+	data, err := pipeline.ExecutionSummary(rt.ScopedResource, rt.Identifier, false)
+	if err != nil {
+		return err
+	}
+
+	summary, ok := data["pipelineExecutionSummary"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf(`no summary in the response of the execution "%s"`, rt.Identifier)
+	}
+	rt.PipelineID, _ = summary["pipelineIdentifier"].(string)
+	rt.Module = "ci"
+	if modules, ok := summary["modules"].([]interface{}); ok && len(modules) > 0 {
+		rt.Module = fmt.Sprint(modules[0])
+	}
+
+	req := rt.Request()
+	rm, err := utils.GetResourceByID(req, executionInputURL, rt.Identifier)
+	if err != nil {
+		return err
+	}
+
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return fmt.Errorf(`unable to get the inputs of the execution "%s", %v`, rt.Identifier, rm["message"])
+	}
+
+	inputs, _ := rm["data"].(map[string]interface{})
+	if y, ok := inputs["inputSetYaml"].(string); ok {
+		rt.InputsYAML = []byte(y)
+	}
+
+	return nil
+}
+
+// AddFlags implements types.Command
+func (ro *RetryOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&ro.ID, "id", "", "", fmt.Sprintf("The identifier of the execution to %s.", ro.action))
+	cmd.MarkFlagRequired("id")
+	if ro.action == "retry" {
+		cmd.Flags().StringVarP(&ro.FromStage, "from-stage", "", "", "The identifier of the stage to retry the execution from, the stage and all the stages after it are run again.")
+		cmd.MarkFlagRequired("from-stage")
+	}
+	ro.ScopeOptions.AddProjectFlags(cmd)
+	ro.WaitOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (ro *RetryOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	rt := &Retry{
+		ScopedResource: ro.Resource(),
+		Action:         ro.action,
+		Identifier:     ro.ID,
+		FromStage:      ro.FromStage,
+	}
+
+	if err := rt.load(); err != nil {
+		return err
+	}
+
+	rt.Print(rt.Call())
+
+	if rt.ExecutionID == "" {
+		return fmt.Errorf(`unable to %s the execution "%s"`, ro.action, ro.ID)
+	}
+
+	return ro.WaitFor(rt.ScopedResource, rt.ExecutionID)
+}
+
+// Validate implements types.Command
+func (ro *RetryOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return ro.WaitOptions.Validate()
+}
+
+var retryCommandExamples = map[string]string{
+	"retry": fmt.Sprintf(`
+# Retry the failed execution from the stage "deploy"
+%[1]s execution retry --id <execution id> --account-id <your account id> --project-id <project id> --from-stage deploy
+# Retry the failed execution from the stage "deploy" and wait for it to finish
+%[1]s execution retry --id <execution id> --account-id <your account id> --project-id <project id> --from-stage deploy --wait
+`, common.ExamplePrefix()),
+	"rerun": fmt.Sprintf(`
+# Re-run the execution with the same inputs and wait for it to finish
+%[1]s execution rerun --id <execution id> --account-id <your account id> --project-id <project id> --wait
+`, common.ExamplePrefix()),
+}
+
+var retryCommandShorts = map[string]string{
+	"retry": "Retries a failed execution from a stage.",
+	"rerun": "Re-runs an execution with the same inputs.",
+}
+
+// newRetryCommands instantiates the new instances of the execution retry and rerun commands
+func newRetryCommands() []*cobra.Command {
+	cmds := []*cobra.Command{}
+	for _, action := range []string{"retry", "rerun"} {
+		ro := &RetryOptions{action: action}
+		rCmd := &cobra.Command{
+			Use:     action,
+			Short:   retryCommandShorts[action],
+			Example: retryCommandExamples[action],
+			RunE:    ro.Execute,
+			PreRunE: ro.Validate,
+		}
+		ro.AddFlags(rCmd)
+		cmds = append(cmds, rCmd)
+	}
+	return cmds
+}
+
+var _ types.Command = (*RetryOptions)(nil)
+var _ types.RESTCall = (*Retry)(nil)
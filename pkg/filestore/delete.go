@@ -0,0 +1,89 @@
+package filestore
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	// The identifier of the file or folder to delete
+	ID string
+}
+
+// DeleteFileNode deletes the file or the folder
+type DeleteFileNode struct {
+	types.ScopedResource
+	// The identifier of the file or folder
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (dn *DeleteFileNode) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(dn.Request(), fileURL, dn.Identifier)
+}
+
+// Print implements types.RESTCall
+func (dn *DeleteFileNode) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("\"%s\" deleted successfully\n", dn.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.ID, "id", "", "", "The identifier of the File Store file or folder to delete.")
+	cmd.MarkFlagRequired("id")
+	do.ScopeOptions.AddFlags(cmd, "filestore")
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	dn := &DeleteFileNode{
+		ScopedResource: do.Resource(),
+		Identifier:     do.ID,
+	}
+
+	dn.Print(dn.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the file of the project File Store
+%[1]s filestore delete --id manifests_values_yaml --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	fCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a file or a folder of the File Store.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(fCmd)
+
+	return fCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteFileNode)(nil)
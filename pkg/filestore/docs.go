@@ -0,0 +1,6 @@
+package filestore
+
+// filestore package has the commands that will be used to manipulate the Harness File Store
+// at account, organization or project scope. The files like the configuration files and the
+// manifests are uploaded into folders, starting from the "Root" folder of the scope.
+// Refer to https://apidocs.harness.io/tag/File-Store for API
@@ -0,0 +1,99 @@
+package filestore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DownloadOptions struct {
+	types.ScopeOptions
+	// The identifier of the file to download
+	ID string
+	// The local file or directory to download to
+	Destination string
+}
+
+// AddFlags implements types.Command
+func (do *DownloadOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.ID, "id", "", "", "The identifier of the File Store file to download.")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringVarP(&do.Destination, "destination", "d", ".", "The local file or an existing directory to download to, the file name is kept when it is a directory.")
+	do.ScopeOptions.AddFlags(cmd, "filestore")
+}
+
+// Execute implements types.Command
+func (do *DownloadOptions) Execute(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	r := do.Resource()
+	node, err := getNode(r, do.ID)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return fmt.Errorf(`file "%s" does not exist`, do.ID)
+	}
+	if node["type"] != fileType {
+		return fmt.Errorf(`"%s" is a folder, only files can be downloaded`, do.ID)
+	}
+
+	req := r.Request()
+	req.SetPathParam("id", do.ID)
+	b, err := utils.GetContent(req, downloadURL)
+	if err != nil {
+		return err
+	}
+
+	dest := do.Destination
+	if fi, err := os.Stat(dest); err == nil && fi.IsDir() {
+		name, _ := node["name"].(string)
+		dest = filepath.Join(dest, name)
+	}
+
+	if err := os.WriteFile(dest, b, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("File \"%s\" downloaded successfully to \"%s\"\n", do.ID, dest)
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DownloadOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var downloadCommandExample = fmt.Sprintf(`
+# Download the file of the project File Store into the current directory
+%[1]s filestore download --id manifests_values_yaml --account-id <your account id> --project-id <project id>
+# Download the file of the account File Store to a local file
+%[1]s filestore download --id manifests_values_yaml --destination /tmp/values.yaml --account-id <your account id> --filestore-scope account
+`, common.ExamplePrefix())
+
+// newDownloadCommand instantiates the new instance of the newDownloadCommand
+func newDownloadCommand() *cobra.Command {
+	do := &DownloadOptions{}
+
+	fCmd := &cobra.Command{
+		Use:     "download",
+		Short:   "Downloads a file of the File Store.",
+		Example: downloadCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(fCmd)
+
+	return fCmd
+}
+
+var _ types.Command = (*DownloadOptions)(nil)
@@ -0,0 +1,54 @@
+package filestore
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFileID(t *testing.T) {
+	tests := []struct {
+		name   string
+		parent string
+		file   string
+		want   string
+	}{
+		{
+			name:   "root folder",
+			parent: rootFolder,
+			file:   "values.yaml",
+			want:   "values_yaml",
+		},
+		{
+			name:   "sub folder",
+			parent: "dev",
+			file:   "values.yaml",
+			want:   "dev_values_yaml",
+		},
+		{
+			name:   "invalid characters",
+			parent: rootFolder,
+			file:   "my-app config.v1.yaml",
+			want:   "my_app_config_v1_yaml",
+		},
+		{
+			name:   "leading digit",
+			parent: rootFolder,
+			file:   "1.yaml",
+			want:   "_1_yaml",
+		},
+		{
+			name:   "too long",
+			parent: rootFolder,
+			file:   strings.Repeat("a", 200),
+			want:   strings.Repeat("a", 128),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fileID(tt.parent, tt.file); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package filestore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+	// The identifier of the folder to list
+	Folder string
+}
+
+// ListFolder lists the files and folders of a File Store folder
+type ListFolder struct {
+	types.ScopedResource
+	Identifier       string `json:"identifier"`
+	Name             string `json:"name"`
+	ParentIdentifier string `json:"parentIdentifier,omitempty"`
+	// Always "FOLDER"
+	Type string `json:"type"`
+}
+
+// Call implements types.RESTCall
+func (lf *ListFolder) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(lf.Request(), folderURL, lf)
+}
+
+// Print implements types.RESTCall
+func (lf *ListFolder) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if children, ok := data["children"].([]interface{}); ok {
+		for _, c := range children {
+			n := c.(map[string]interface{})
+			resMap = append(resMap, map[string]interface{}{
+				"name":  n["name"],
+				"id":    n["identifier"],
+				"type":  n["type"],
+				"path":  n["path"],
+				"usage": n["fileUsage"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Folder, "folder", "", rootFolder, "The identifier of the File Store folder to list.")
+	lo.ScopeOptions.AddFlags(cmd, "filestore")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lf := &ListFolder{
+		ScopedResource: lo.Resource(),
+		Identifier:     lo.Folder,
+		Name:           lo.Folder,
+		Type:           folderType,
+	}
+
+	if lo.Folder != rootFolder {
+		node, err := getNode(lf.ScopedResource, lo.Folder)
+		if err != nil {
+			return err
+		}
+		if node == nil || node["type"] != folderType {
+			return fmt.Errorf(`folder "%s" does not exist`, lo.Folder)
+		}
+		lf.Name, _ = node["name"].(string)
+		lf.ParentIdentifier, _ = node["parentIdentifier"].(string)
+	}
+
+	lf.Print(lf.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the root folder of the project File Store
+%[1]s filestore list --account-id <your account id> --project-id <project id>
+# List the folder of the account File Store
+%[1]s filestore list --folder manifests --account-id <your account id> --filestore-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	fCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the files and folders of a File Store folder.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(fCmd)
+
+	return fCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListFolder)(nil)
@@ -0,0 +1,67 @@
+package filestore
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"unicode"
+
+	"github.com/kameshsampath/harness-cli/pkg/types"
+)
+
+const (
+	fileStoreURL = "https://app.harness.io/gateway/ng/api/file-store"
+	fileURL      = "https://app.harness.io/gateway/ng/api/file-store/{id}"
+	folderURL    = "https://app.harness.io/gateway/ng/api/file-store/folder"
+	downloadURL  = "https://app.harness.io/gateway/ng/api/file-store/files/{id}/download"
+
+	// rootFolder is the identifier of the root folder of the File Store
+	rootFolder = "Root"
+	fileType   = "FILE"
+	folderType = "FOLDER"
+)
+
+// fileUsages are the valid usages of a file
+var fileUsages = []string{"MANIFEST_FILE", "CONFIG", "SCRIPT"}
+
+var invalidIDChars = regexp.MustCompile(`[^0-9a-zA-Z_]`)
+
+// fileID returns the identifier of the file or folder "name" in the folder "parent",
+// the identifier is prefixed with the parent identifier to keep the same file names
+// of different folders unique e.g. "values.yaml" of "dev" folder is "dev_values_yaml"
+func fileID(parent, name string) string {
+	id := invalidIDChars.ReplaceAllString(name, "_")
+	if parent != rootFolder {
+		id = parent + "_" + id
+	}
+	if unicode.IsDigit(rune(id[0])) {
+		id = "_" + id
+	}
+	if len(id) > 128 {
+		id = id[:128]
+	}
+	return id
+}
+
+// getNode gets the file or folder by its identifier, returns nil when it does not exist
+func getNode(r types.ScopedResource, id string) (map[string]interface{}, error) {
+	req := r.Request()
+	req.SetPathParam("id", id)
+	resp, err := req.Get(fileURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	rm := *resp.Result().(*map[string]interface{})
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return nil, fmt.Errorf(`unable to get "%s", %v`, id, rm["message"])
+	}
+	node, ok := rm["data"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf(`unable to get "%s", %v`, id, rm)
+	}
+	return node, nil
+}
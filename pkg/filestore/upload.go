@@ -0,0 +1,214 @@
+package filestore
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type UploadOptions struct {
+	types.ScopeOptions
+	// The local file or directory to upload
+	Path string
+	// The identifier of the File Store folder to upload into
+	Folder string
+	// The usage of the files "MANIFEST_FILE", "CONFIG" or "SCRIPT"
+	Usage string
+}
+
+// FileNode creates or updates a file or a folder of the File Store
+type FileNode struct {
+	types.ScopedResource
+	Identifier string
+	Name       string
+	// "FILE" or "FOLDER"
+	Type string
+	// The identifier of the parent folder
+	ParentIdentifier string
+	// The usage of the file "MANIFEST_FILE", "CONFIG" or "SCRIPT"
+	FileUsage string
+	// The local file to upload, used only with "FILE" type
+	File string
+	// whether to update the existing file
+	update bool
+}
+
+// Call implements types.RESTCall
+func (fn *FileNode) Call() (map[string]interface{}, error) {
+	form := map[string]string{
+		"identifier":       fn.Identifier,
+		"name":             fn.Name,
+		"type":             fn.Type,
+		"parentIdentifier": fn.ParentIdentifier,
+	}
+
+	files := map[string]string{}
+	if fn.Type == fileType {
+		files["content"] = fn.File
+		if fn.FileUsage != "" {
+			form["fileUsage"] = fn.FileUsage
+		}
+	}
+
+	if fn.update {
+		req := fn.Request()
+		req.SetPathParam("id", fn.Identifier)
+		return utils.PutMultipart(req, fileURL, files, form)
+	}
+	return utils.PostMultipart(fn.Request(), fileStoreURL, files, form)
+}
+
+// Print implements types.RESTCall
+func (fn *FileNode) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	if fn.Type == folderType {
+		fmt.Printf("Folder \"%s\" created successfully\n", fn.Identifier)
+		return
+	}
+
+	action := "uploaded"
+	if fn.update {
+		action = "updated"
+	}
+	fmt.Printf("File \"%s\" %s successfully as \"%s\"\n", fn.File, action, fn.Identifier)
+}
+
+// upload creates or updates the file or the folder, folders that already exist are reused
+func (uo *UploadOptions) upload(fn *FileNode) error {
+	node, err := getNode(fn.ScopedResource, fn.Identifier)
+	if err != nil {
+		return err
+	}
+	if node != nil {
+		// the identifier of a different file name or folder, the node must not be overwritten
+		if node["name"] != fn.Name || node["parentIdentifier"] != fn.ParentIdentifier || node["type"] != fn.Type {
+			return fmt.Errorf(`"%s" can not be uploaded, its identifier "%s" is used by "%v" in folder "%v"`,
+				fn.File, fn.Identifier, node["name"], node["parentIdentifier"])
+		}
+		if fn.Type == folderType {
+			log.Infof("Folder \"%s\" already exists", fn.Identifier)
+			return nil
+		}
+		fn.update = true
+	}
+
+	rm, err := fn.Call()
+	fn.Print(rm, err)
+	if err != nil || rm["status"] != "SUCCESS" {
+		return fmt.Errorf("uploading \"%s\" failed", fn.Name)
+	}
+	return nil
+}
+
+// AddFlags implements types.Command
+func (uo *UploadOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&uo.Path, "path", "", "", "The local file or directory to upload, the directory tree is uploaded as folders.")
+	cmd.MarkFlagRequired("path")
+	cmd.Flags().StringVarP(&uo.Folder, "folder", "", rootFolder, "The identifier of the File Store folder to upload into.")
+	cmd.Flags().StringVarP(&uo.Usage, "usage", "", "", `The usage of the files. Valid value is one of "MANIFEST_FILE", "CONFIG", "SCRIPT"`)
+	uo.ScopeOptions.AddFlags(cmd, "filestore")
+}
+
+// Execute implements types.Command
+func (uo *UploadOptions) Execute(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	r := uo.Resource()
+	root, err := filepath.Abs(uo.Path)
+	if err != nil {
+		return err
+	}
+	// the identifiers of the uploaded directories, keyed by their local path
+	folders := map[string]string{filepath.Dir(root): uo.Folder}
+	// the local paths of the uploaded files and directories, keyed by their identifier
+	paths := map[string]string{}
+
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		parent := folders[filepath.Dir(path)]
+		fn := &FileNode{
+			ScopedResource:   r,
+			Identifier:       fileID(parent, info.Name()),
+			Name:             info.Name(),
+			Type:             fileType,
+			ParentIdentifier: parent,
+			FileUsage:        uo.Usage,
+			File:             path,
+		}
+
+		if p, ok := paths[fn.Identifier]; ok {
+			return fmt.Errorf(`"%s" and "%s" have the same identifier "%s", rename one of them`, p, path, fn.Identifier)
+		}
+		paths[fn.Identifier] = path
+
+		if info.IsDir() {
+			fn.Type = folderType
+			folders[path] = fn.Identifier
+		}
+
+		return uo.upload(fn)
+	})
+}
+
+// Validate implements types.Command
+func (uo *UploadOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if _, err := os.Stat(uo.Path); err != nil {
+		return err
+	}
+
+	if uo.Usage != "" {
+		valid := false
+		for _, u := range fileUsages {
+			valid = valid || u == uo.Usage
+		}
+		if !valid {
+			return fmt.Errorf(`"usage" should be one of "MANIFEST_FILE", "CONFIG" or "SCRIPT"`)
+		}
+	}
+
+	return uo.ScopeOptions.Validate()
+}
+
+var uploadCommandExample = fmt.Sprintf(`
+# Upload the file into the root folder of the project File Store
+%[1]s filestore upload --path values.yaml --account-id <your account id> --project-id <project id>
+# Upload the manifests directory tree into the account File Store, the folder "manifests" is created when not exists
+%[1]s filestore upload --path ./manifests --usage MANIFEST_FILE --account-id <your account id> --filestore-scope account
+# Upload the file into an existing folder
+%[1]s filestore upload --path values.yaml --folder manifests --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newUploadCommand instantiates the new instance of the newUploadCommand
+func newUploadCommand() *cobra.Command {
+	uo := &UploadOptions{}
+
+	fCmd := &cobra.Command{
+		Use:     "upload",
+		Short:   "Uploads a file or a directory tree into a File Store folder.",
+		Example: uploadCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(fCmd)
+
+	return fCmd
+}
+
+var _ types.Command = (*UploadOptions)(nil)
+var _ types.RESTCall = (*FileNode)(nil)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/delegate"
 	"github.com/kameshsampath/harness-cli/pkg/types"
 	"github.com/kameshsampath/harness-cli/pkg/utils"
 	"github.com/spf13/cobra"
@@ -25,6 +26,7 @@ type CreateOptions struct {
 	Scope              string
 	SecretKey          string
 	DelegateSelectors  []string
+	CheckDelegateTags  bool
 }
 
 type ManualAuth struct {
@@ -52,6 +54,7 @@ func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&co.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
 	cmd.Flags().BoolVarP(&co.ExecuteOnDelegate, "execute-on-delegate", "", true, "Allow the connector to execute on delegate.")
 	cmd.Flags().StringSliceVarP(&co.DelegateSelectors, "delegate-tags", "", []string{}, `The delegate tags that will be used to select the available delegates when the "auth-type" is "delegate"`)
+	cmd.Flags().BoolVarP(&co.CheckDelegateTags, "check-delegate-tags", "", false, "Warn when none of the delegate tags match an existing delegate.")
 }
 
 // Execute implements types.Command
@@ -93,6 +96,10 @@ func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
 
 	c.Spec = spec
 
+	if co.CheckDelegateTags {
+		delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, co.DelegateSelectors)
+	}
+
 	ci := &types.ConnectorInfo{
 		ConnectorInfo: *c,
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/delegate"
 	"github.com/kameshsampath/harness-cli/pkg/types"
 	"github.com/kameshsampath/harness-cli/pkg/utils"
 	"github.com/spf13/cobra"
@@ -16,6 +17,7 @@ type CreateOptions struct {
 	// Token or GithubApp or OAuth
 	APIAccessType       string
 	DelegateSelectors   []string
+	CheckDelegateTags   bool
 	Name                string
 	ExecuteOnDelegate   bool
 	EnableAPIAccess     bool
@@ -23,6 +25,13 @@ type CreateOptions struct {
 	UserName            string
 	PersonalAccessToken string
 	Scope               string
+	// GitHub App installation and application IDs, used when APIAccessType is GithubApp
+	InstallationID string
+	ApplicationID  string
+	// The secret ID of the GitHub App private key, used when APIAccessType is GithubApp
+	PrivateKey string
+	// The secret ID of the OAuth token, used when APIAccessType is OAuth
+	OAuthToken string
 	// Account or Repo
 	URLType        string
 	URL            string
@@ -33,6 +42,16 @@ type APIAccessTokenSpec struct {
 	TokenRef string `json:"tokenRef"`
 }
 
+type APIAccessGitHubAppSpec struct {
+	InstallationID string `json:"installationId"`
+	ApplicationID  string `json:"applicationId"`
+	PrivateKeyRef  string `json:"privateKeyRef"`
+}
+
+type APIAccessOAuthSpec struct {
+	TokenRef string `json:"tokenRef"`
+}
+
 type APIAccess struct {
 	// "GithubApp" "Token" "OAuth"
 	Type string      `json:"type"`
@@ -43,8 +62,9 @@ type PATCredentialsSpec struct {
 	PersonalAccessToken string `json:"tokenRef"`
 }
 type HTTPCredentialsSpec struct {
-	Type string             `json:"type"`
-	Spec PATCredentialsSpec `json:"spec"`
+	// "UsernameToken" "GithubApp" "OAuth"
+	Type string      `json:"type"`
+	Spec interface{} `json:"spec"`
 }
 
 type SSHCredentialsSpec struct {
@@ -73,8 +93,7 @@ func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("name")
 	cmd.Flags().StringVarP(&co.UserName, "username", "u", "", "The GitHub user name.")
 	cmd.MarkFlagRequired("username")
-	cmd.Flags().StringVarP(&co.PersonalAccessToken, "pat", "", "", "The GitHub user Personal access Token(PAT) secret ID. Use scope for finer access e.g. account.mypat, org.mypat etc.,")
-	cmd.MarkFlagRequired("pat")
+	cmd.Flags().StringVarP(&co.PersonalAccessToken, "pat", "", "", `The GitHub user Personal access Token(PAT) secret ID. Use scope for finer access e.g. account.mypat, org.mypat etc., Required when "api-access-type" is "Token"`)
 	cmd.Flags().StringVarP(&co.AuthenticationType, "auth-type", "", "Http", "The github authentication type. Valid values are Http or Ssh")
 	cmd.Flags().StringVarP(&co.URL, "url", "", "", "The GitHub account URL e.g. https://github.com/org-name")
 	cmd.MarkFlagRequired("url")
@@ -83,9 +102,14 @@ func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVarP(&co.ProjectID, "project-id", "p", "", `The project where the connector will be created.`)
 	cmd.Flags().StringVarP(&co.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
 	cmd.Flags().BoolVarP(&co.ExecuteOnDelegate, "execute-on-delegate", "", true, "Allow the connector to execute on available delegate.")
-	cmd.Flags().BoolVarP(&co.EnableAPIAccess, "enable-api-access", "", true, "Enable GitHub API Access. Only token type supported.")
+	cmd.Flags().BoolVarP(&co.EnableAPIAccess, "enable-api-access", "", true, "Enable GitHub API Access.")
 	cmd.Flags().StringVarP(&co.APIAccessType, "api-access-type", "", "Token", `GitHub API Access type. One of "Token" or "GithubApp" or "OAuth"`)
+	cmd.Flags().StringVarP(&co.InstallationID, "installation-id", "", "", `The GitHub App installation ID. Required when "api-access-type" is "GithubApp"`)
+	cmd.Flags().StringVarP(&co.ApplicationID, "application-id", "", "", `The GitHub App application ID. Required when "api-access-type" is "GithubApp"`)
+	cmd.Flags().StringVarP(&co.PrivateKey, "private-key", "", "", `The GitHub App private key secret ID. Required when "api-access-type" is "GithubApp"`)
+	cmd.Flags().StringVarP(&co.OAuthToken, "oauth-token", "", "", `The GitHub OAuth token secret ID. Required when "api-access-type" is "OAuth"`)
 	cmd.Flags().StringSliceVarP(&co.DelegateSelectors, "delegate-tags", "", []string{}, `The delegate tags that will be used to select the available delegate that will be used by the connector.`)
+	cmd.Flags().BoolVarP(&co.CheckDelegateTags, "check-delegate-tags", "", false, "Warn when none of the delegate tags match an existing delegate.")
 }
 
 // Execute implements types.Command
@@ -123,13 +147,35 @@ func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
 	}
 
 	if co.AuthenticationType == "Http" {
-		spec.Authentication.Spec = HTTPCredentialsSpec{
+		hc := HTTPCredentialsSpec{
 			Type: "UsernameToken",
 			Spec: PATCredentialsSpec{
 				UserName:            co.UserName,
 				PersonalAccessToken: scopedName(co.Scope, co.PersonalAccessToken),
 			},
 		}
+		// without the PAT the clone uses the credentials of the API access
+		if co.PersonalAccessToken == "" {
+			switch co.APIAccessType {
+			case "GithubApp":
+				hc = HTTPCredentialsSpec{
+					Type: "GithubApp",
+					Spec: APIAccessGitHubAppSpec{
+						InstallationID: co.InstallationID,
+						ApplicationID:  co.ApplicationID,
+						PrivateKeyRef:  scopedName(co.Scope, co.PrivateKey),
+					},
+				}
+			case "OAuth":
+				hc = HTTPCredentialsSpec{
+					Type: "OAuth",
+					Spec: APIAccessOAuthSpec{
+						TokenRef: scopedName(co.Scope, co.OAuthToken),
+					},
+				}
+			}
+		}
+		spec.Authentication.Spec = hc
 	} else if co.AuthenticationType == "Ssh" {
 		spec.Authentication.Spec = SSHCredentialsSpec{}
 	}
@@ -137,9 +183,22 @@ func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
 	if co.EnableAPIAccess {
 		spec.APIAccess = APIAccess{
 			Type: co.APIAccessType,
-			Spec: APIAccessTokenSpec{
+		}
+		switch co.APIAccessType {
+		case "GithubApp":
+			spec.APIAccess.Spec = APIAccessGitHubAppSpec{
+				InstallationID: co.InstallationID,
+				ApplicationID:  co.ApplicationID,
+				PrivateKeyRef:  scopedName(co.Scope, co.PrivateKey),
+			}
+		case "OAuth":
+			spec.APIAccess.Spec = APIAccessOAuthSpec{
+				TokenRef: scopedName(co.Scope, co.OAuthToken),
+			}
+		default:
+			spec.APIAccess.Spec = APIAccessTokenSpec{
 				TokenRef: scopedName(co.Scope, co.PersonalAccessToken),
-			},
+			}
 		}
 	}
 
@@ -149,6 +208,10 @@ func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
 
 	c.Spec = spec
 
+	if co.CheckDelegateTags {
+		delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, co.DelegateSelectors)
+	}
+
 	ci := &types.ConnectorInfo{
 		ConnectorInfo: *c,
 	}
@@ -173,6 +236,31 @@ func scopedName(scope, name string) string {
 // Validate implements types.Command
 func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
 	viper.BindPFlags(cmd.Flags())
+
+	if !co.EnableAPIAccess {
+		if co.AuthenticationType == "Http" && co.PersonalAccessToken == "" {
+			return fmt.Errorf(`"pat" is required when "auth-type" is "Http" and the API access is disabled`)
+		}
+		return nil
+	}
+
+	switch co.APIAccessType {
+	case "Token":
+		if co.PersonalAccessToken == "" {
+			return fmt.Errorf(`"pat" is required when "api-access-type" is "Token"`)
+		}
+	case "GithubApp":
+		if co.InstallationID == "" || co.ApplicationID == "" || co.PrivateKey == "" {
+			return fmt.Errorf(`"installation-id", "application-id" and "private-key" are required when "api-access-type" is "GithubApp"`)
+		}
+	case "OAuth":
+		if co.OAuthToken == "" {
+			return fmt.Errorf(`"oauth-token" is required when "api-access-type" is "OAuth"`)
+		}
+	default:
+		return fmt.Errorf(`"api-access-type" should be one of "Token" "GithubApp" "OAuth"`)
+	}
+
 	return nil
 }
 
@@ -181,6 +269,10 @@ var ghcCommandExample = fmt.Sprintf(`
   %[1]s github new --name github --account-id <your account id> 
   # Create project with specific organization id
   %[1]s github new --name github --account-id <your account id> --org-id=<orgid>
+  # Create gh-connector that uses a GitHub App for API access
+  %[1]s github new --name github --account-id <your account id> --api-access-type GithubApp --installation-id <installation id> --application-id <application id> --private-key <private key secret id>
+  # Create gh-connector that uses an OAuth token for API access
+  %[1]s github new --name github --account-id <your account id> --api-access-type OAuth --oauth-token <oauth token secret id>
 `, common.ExamplePrefix())
 
 // newGitHubConnectorCommand instantiates the new instance of the newGitHubConnectorCommand
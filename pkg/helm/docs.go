@@ -0,0 +1,3 @@
+package helm
+
+// helm package defines the commands to manipulate HTTP Helm repository and OCI Helm registry connector resources
@@ -0,0 +1,97 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	// The identifier of the environment of the infrastructure
+	Environment string
+	Name        string
+}
+
+// DeleteInfrastructure deletes the infrastructure
+type DeleteInfrastructure struct {
+	types.ScopedResource
+	// The identifier of the environment of the infrastructure
+	EnvironmentIdentifier string
+	// The identifier of the infrastructure
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (di *DeleteInfrastructure) Call() (map[string]interface{}, error) {
+	req := di.Request()
+	req.SetQueryParam("environmentIdentifier", di.EnvironmentIdentifier)
+	return utils.DeleteResourceByID(req, infrastructureURL, di.Identifier)
+}
+
+// Print implements types.RESTCall
+func (di *DeleteInfrastructure) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Infrastructure \"%s\" deleted successfully\n", di.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the infrastructure to delete.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&do.Environment, "environment", "e", "", "The identifier of the environment of the infrastructure.")
+	cmd.MarkFlagRequired("environment")
+	do.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	di := &DeleteInfrastructure{
+		ScopedResource:        do.Resource(),
+		EnvironmentIdentifier: do.Environment,
+		Identifier:            do.Name,
+	}
+
+	di.Print(di.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the infrastructure
+%[1]s infrastructure delete --environment qa --name k8s-dev --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes an infrastructure definition of an environment.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteInfrastructure)(nil)
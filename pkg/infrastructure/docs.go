@@ -0,0 +1,5 @@
+package infrastructure
+
+// infrastructure package has the commands that will be used to manipulate the Harness
+// infrastructure definitions of the environments from their YAML.
+// Refer to https://apidocs.harness.io/tag/Infrastructures for API
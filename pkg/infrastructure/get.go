@@ -0,0 +1,100 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	// The identifier of the environment of the infrastructure
+	Environment string
+	Name        string
+}
+
+// GetInfrastructure gets the infrastructure YAML
+type GetInfrastructure struct {
+	types.ScopedResource
+	// The identifier of the environment of the infrastructure
+	EnvironmentIdentifier string
+	// The identifier of the infrastructure
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (gi *GetInfrastructure) Call() (map[string]interface{}, error) {
+	req := gi.Request()
+	req.SetQueryParam("environmentIdentifier", gi.EnvironmentIdentifier)
+	return utils.GetResourceByID(req, infrastructureURL, gi.Identifier)
+}
+
+// Print implements types.RESTCall
+func (gi *GetInfrastructure) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	data := rm["data"].(map[string]interface{})
+	if inf, ok := data["infrastructure"].(map[string]interface{}); ok {
+		fmt.Print(inf["yaml"])
+	}
+}
+
+// AddFlags implements types.Command
+func (gio *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gio.Name, "name", "n", "", "The identifier of the infrastructure.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&gio.Environment, "environment", "e", "", "The identifier of the environment of the infrastructure.")
+	cmd.MarkFlagRequired("environment")
+	gio.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (gio *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gi := &GetInfrastructure{
+		ScopedResource:        gio.Resource(),
+		EnvironmentIdentifier: gio.Environment,
+		Identifier:            gio.Name,
+	}
+
+	gi.Print(gi.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gio *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return gio.ScopeOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the infrastructure YAML
+%[1]s infrastructure get --environment qa --name k8s-dev --account-id <your account id> --project-id <project id>
+# Get the YAML of an infrastructure of an organization environment
+%[1]s infrastructure get --environment qa --name k8s-dev --account-id <your account id> --environment-scope org
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gio := &GetOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets the infrastructure YAML.",
+		Example: getCommandExample,
+		RunE:    gio.Execute,
+		PreRunE: gio.Validate,
+	}
+
+	gio.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetInfrastructure)(nil)
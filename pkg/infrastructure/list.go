@@ -0,0 +1,116 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+	// The identifier of the environment of the infrastructure
+	Environment string
+}
+
+// ListInfrastructures lists the infrastructures of the environment
+type ListInfrastructures struct {
+	types.ScopedResource
+	// The identifier of the environment of the infrastructure
+	EnvironmentIdentifier string
+}
+
+// Call implements types.RESTCall
+func (li *ListInfrastructures) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := li.Request()
+		req.SetQueryParam("environmentIdentifier", li.EnvironmentIdentifier)
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		return utils.GetJSON(req, infrastructuresURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (li *ListInfrastructures) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			inf, ok := c.(map[string]interface{})["infrastructure"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			resMap = append(resMap, map[string]interface{}{
+				"name":        inf["name"],
+				"id":          inf["identifier"],
+				"description": inf["description"],
+				"tags":        inf["tags"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Environment, "environment", "e", "", "The identifier of the environment of the infrastructure.")
+	cmd.MarkFlagRequired("environment")
+	lo.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	li := &ListInfrastructures{
+		ScopedResource:        lo.Resource(),
+		EnvironmentIdentifier: lo.Environment,
+	}
+
+	li.Print(li.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the infrastructures of the environment
+%[1]s infrastructure list --environment qa --account-id <your account id> --project-id <project id>
+# List the infrastructures of an account environment
+%[1]s infrastructure list --environment qa --account-id <your account id> --environment-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the infrastructures of an environment.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListInfrastructures)(nil)
@@ -0,0 +1,82 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	// The infrastructure YAML file
+	File string
+}
+
+// Call implements types.RESTCall
+func (inf *Infrastructure) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(inf.Request(), infrastructuresURL, inf)
+}
+
+// Print implements types.RESTCall
+func (inf *Infrastructure) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Infrastructure \"%s\" created successfully\n", inf.Identifier)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", "The infrastructure definition YAML file.")
+	cmd.MarkFlagRequired("file")
+	co.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	inf, err := readInfrastructure(co.Resource(), co.File)
+	if err != nil {
+		return err
+	}
+
+	inf.Print(inf.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return co.ScopeOptions.Validate()
+}
+
+var createCommandExample = fmt.Sprintf(`
+# Create the infrastructure from its YAML
+%[1]s infrastructure new --file infrastructure.yaml --account-id <your account id> --project-id <project id>
+# Create the infrastructure of an account environment
+%[1]s infrastructure new --file infrastructure.yaml --account-id <your account id> --environment-scope account
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new infrastructure definition of an environment from its YAML.",
+		Example: createCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*Infrastructure)(nil)
@@ -0,0 +1,59 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+)
+
+const (
+	infrastructuresURL = "https://app.harness.io/gateway/ng/api/infrastructures"
+	infrastructureURL  = "https://app.harness.io/gateway/ng/api/infrastructures/{id}"
+)
+
+// Infrastructure holds the infrastructure definition of an environment that will be created or updated from its YAML
+type Infrastructure struct {
+	types.ScopedResource
+	Identifier  string `json:"identifier"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	// The identifier of the environment of the infrastructure
+	EnvironmentRef string `json:"environmentRef"`
+	// The infrastructure type e.g. "KubernetesDirect"
+	Type string `json:"type,omitempty"`
+	// The deployment type e.g. "Kubernetes"
+	DeploymentType    string            `json:"deploymentType,omitempty"`
+	OrgIdentifier     string            `json:"orgIdentifier,omitempty"`
+	ProjectIdentifier string            `json:"projectIdentifier,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
+	// The infrastructure YAML
+	YAML string `json:"yaml"`
+}
+
+// readInfrastructure reads the infrastructure YAML file and builds the Infrastructure at the scope of the resource
+func readInfrastructure(r types.ScopedResource, file string) (*Infrastructure, error) {
+	b, inf, err := utils.ReadYAMLResource(file, "infrastructureDefinition")
+	if err != nil {
+		return nil, err
+	}
+
+	envRef := utils.StringValue(inf, "environmentRef")
+	if envRef == "" {
+		return nil, fmt.Errorf(`"%s" has no "infrastructureDefinition.environmentRef"`, file)
+	}
+
+	return &Infrastructure{
+		ScopedResource:    r,
+		Identifier:        utils.StringValue(inf, "identifier"),
+		Name:              utils.StringValue(inf, "name"),
+		Description:       utils.StringValue(inf, "description"),
+		EnvironmentRef:    envRef,
+		Type:              utils.StringValue(inf, "type"),
+		DeploymentType:    utils.StringValue(inf, "deploymentType"),
+		OrgIdentifier:     r.OrgID,
+		ProjectIdentifier: r.ProjectID,
+		Tags:              utils.TagsValue(inf),
+		YAML:              string(b),
+	}, nil
+}
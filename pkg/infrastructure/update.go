@@ -0,0 +1,86 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type UpdateOptions struct {
+	types.ScopeOptions
+	// The infrastructure YAML file
+	File string
+}
+
+// UpdateInfrastructure updates the infrastructure with its YAML
+type UpdateInfrastructure struct {
+	*Infrastructure
+}
+
+// Call implements types.RESTCall
+func (ui *UpdateInfrastructure) Call() (map[string]interface{}, error) {
+	return utils.PutJSON(ui.Request(), infrastructuresURL, ui.Infrastructure)
+}
+
+// Print implements types.RESTCall
+func (ui *UpdateInfrastructure) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Infrastructure \"%s\" updated successfully\n", ui.Identifier)
+}
+
+// AddFlags implements types.Command
+func (uo *UpdateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&uo.File, "file", "f", "", "The infrastructure definition YAML file. the infrastructure is identified by its identifier.")
+	cmd.MarkFlagRequired("file")
+	uo.ScopeOptions.AddFlags(cmd, "environment")
+}
+
+// Execute implements types.Command
+func (uo *UpdateOptions) Execute(cmd *cobra.Command, args []string) error {
+	inf, err := readInfrastructure(uo.Resource(), uo.File)
+	if err != nil {
+		return err
+	}
+
+	ui := &UpdateInfrastructure{inf}
+	ui.Print(ui.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (uo *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return uo.ScopeOptions.Validate()
+}
+
+var updateCommandExample = fmt.Sprintf(`
+# Update the infrastructure from its YAML
+%[1]s infrastructure update --file infrastructure.yaml --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newUpdateCommand instantiates the new instance of the newUpdateCommand
+func newUpdateCommand() *cobra.Command {
+	uo := &UpdateOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Updates the infrastructure from its YAML.",
+		Example: updateCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*UpdateOptions)(nil)
+var _ types.RESTCall = (*UpdateInfrastructure)(nil)
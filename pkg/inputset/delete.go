@@ -0,0 +1,88 @@
+package inputset
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	PipelineOptions
+	Name string
+}
+
+// DeleteInputSet deletes the input set or the overlay input set
+type DeleteInputSet struct {
+	InputSet
+	// The identifier of the input set
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (di *DeleteInputSet) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(di.request(), inputSetURL, di.Identifier)
+}
+
+// Print implements types.RESTCall
+func (di *DeleteInputSet) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Input set \"%s\" deleted successfully\n", di.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the input set to delete.")
+	cmd.MarkFlagRequired("name")
+	do.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	di := &DeleteInputSet{
+		InputSet:   do.inputSet(),
+		Identifier: do.Name,
+	}
+
+	di.Print(di.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the input set of the pipeline
+%[1]s inputset delete --name staging --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes an input set or overlay input set.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteInputSet)(nil)
@@ -0,0 +1,4 @@
+package inputset
+
+// inputset package has the commands that will be used to manipulate the input sets and the overlay input sets of the Harness Pipelines.
+// Refer to https://apidocs.harness.io/tag/Input-Sets for API
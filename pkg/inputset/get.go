@@ -0,0 +1,102 @@
+package inputset
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	PipelineOptions
+	Name string
+}
+
+// GetInputSet gets the input set or the overlay input set YAML
+type GetInputSet struct {
+	InputSet
+	// The identifier of the input set
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (gi *GetInputSet) Call() (map[string]interface{}, error) {
+	rm, err := utils.GetResourceByID(gi.request(), inputSetURL, gi.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	// not an input set, try if it is an overlay input set
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return utils.GetResourceByID(gi.request(), overlayInputSetURL, gi.Identifier)
+	}
+
+	return rm, nil
+}
+
+// Print implements types.RESTCall
+func (gi *GetInputSet) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	data := rm["data"].(map[string]interface{})
+	if y, ok := data["overlayInputSetYaml"]; ok {
+		fmt.Print(y)
+		return
+	}
+	fmt.Print(data["inputSetYaml"])
+}
+
+// AddFlags implements types.Command
+func (gpo *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gpo.Name, "name", "n", "", "The identifier of the input set.")
+	cmd.MarkFlagRequired("name")
+	gpo.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (gpo *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gi := &GetInputSet{
+		InputSet:   gpo.inputSet(),
+		Identifier: gpo.Name,
+	}
+
+	gi.Print(gi.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gpo *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the input set YAML
+%[1]s inputset get --name staging --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gpo := &GetOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets the input set or overlay input set YAML.",
+		Example: getCommandExample,
+		RunE:    gpo.Execute,
+		PreRunE: gpo.Validate,
+	}
+
+	gpo.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetInputSet)(nil)
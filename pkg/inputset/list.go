@@ -0,0 +1,124 @@
+package inputset
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// inputSetTypes maps the "type" flag to the Harness input set type
+var inputSetTypes = map[string]string{
+	"all":      "ALL",
+	"inputset": "INPUT_SET",
+	"overlay":  "OVERLAY_INPUT_SET",
+}
+
+type ListOptions struct {
+	PipelineOptions
+	// "all", "inputset" or "overlay"
+	Type string
+}
+
+// ListInputSets lists the input sets of the pipeline
+type ListInputSets struct {
+	InputSet
+	// "ALL", "INPUT_SET" or "OVERLAY_INPUT_SET"
+	Type string
+}
+
+// Call implements types.RESTCall
+func (li *ListInputSets) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := li.request()
+		req.SetQueryParam("inputSetType", li.Type)
+		req.SetQueryParam("pageIndex", fmt.Sprint(index))
+		req.SetQueryParam("pageSize", "100")
+		return utils.GetJSON(req, inputSetsURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (li *ListInputSets) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	content, _ := data["content"].([]interface{})
+	for _, c := range content {
+		is := c.(map[string]interface{})
+		resMap = append(resMap, map[string]interface{}{
+			"name":        is["name"],
+			"id":          is["identifier"],
+			"type":        is["inputSetType"],
+			"description": is["description"],
+			"tags":        is["tags"],
+		})
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Type, "type", "", "all", `The type of the input sets to list. Valid values are "all", "inputset" or "overlay"`)
+	lo.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	li := &ListInputSets{
+		InputSet: lo.inputSet(),
+		Type:     inputSetTypes[lo.Type],
+	}
+
+	li.Print(li.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if _, ok := inputSetTypes[lo.Type]; !ok {
+		return fmt.Errorf(`"type" should be one of "all", "inputset" or "overlay"`)
+	}
+
+	return nil
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the input sets of the pipeline
+%[1]s inputset list --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+# List only the overlay input sets of the pipeline
+%[1]s inputset list --pipeline my_pipeline --account-id <your account id> --project-id <project id> --type overlay
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the input sets of the pipeline.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListInputSets)(nil)
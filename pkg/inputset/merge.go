@@ -0,0 +1,71 @@
+package inputset
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type MergeOptions struct {
+	PipelineOptions
+	// The names or identifiers of the input sets to merge
+	InputSets []string
+	// The git branch of the pipeline, used with pipelines stored in git
+	Branch string
+}
+
+// AddFlags implements types.Command
+func (mo *MergeOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&mo.InputSets, "input-set", "i", []string{}, "The identifiers of the input sets to merge, the input sets are merged in the order they are specified.")
+	cmd.MarkFlagRequired("input-set")
+	cmd.Flags().StringVarP(&mo.Branch, "branch", "b", "", "The git branch of the pipeline, used only with the pipelines stored in git.")
+	mo.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (mo *MergeOptions) Execute(cmd *cobra.Command, args []string) error {
+	is := mo.inputSet()
+
+	inputs, err := pipeline.MergeInputSets(is.ScopedResource, is.PipelineID, mo.Branch, mo.InputSets)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(inputs)
+
+	return nil
+}
+
+// Validate implements types.Command
+func (mo *MergeOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var mergeCommandExample = fmt.Sprintf(`
+# Show the effective runtime inputs of the input sets "defaults" and "staging"
+%[1]s inputset merge --input-set defaults,staging --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newMergeCommand instantiates the new instance of the newMergeCommand
+func newMergeCommand() *cobra.Command {
+	mo := &MergeOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "merge",
+		Short:   "Shows the effective runtime inputs of a combination of input sets.",
+		Example: mergeCommandExample,
+		RunE:    mo.Execute,
+		PreRunE: mo.Validate,
+	}
+
+	mo.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*MergeOptions)(nil)
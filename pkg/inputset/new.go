@@ -0,0 +1,103 @@
+package inputset
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	PipelineOptions
+	// The input set or overlay input set YAML file
+	File string
+}
+
+// CreateInputSet creates the input set or the overlay input set from its YAML
+type CreateInputSet struct {
+	InputSet
+	// The identifier of the input set
+	Identifier string
+	// Is the input set an overlay input set
+	Overlay bool
+	// The input set YAML
+	YAML []byte
+}
+
+// Call implements types.RESTCall
+func (ci *CreateInputSet) Call() (map[string]interface{}, error) {
+	if ci.Overlay {
+		return utils.PostYAML(ci.request(), overlayInputSetsURL, ci.YAML)
+	}
+	return utils.PostYAML(ci.request(), inputSetsURL, ci.YAML)
+}
+
+// Print implements types.RESTCall
+func (ci *CreateInputSet) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Input set \"%s\" created successfully\n", ci.Identifier)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", `The input set YAML file, an overlay input set when its root is "overlayInputSet".`)
+	cmd.MarkFlagRequired("file")
+	co.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	b, id, overlay, err := readInputSet(co.File)
+	if err != nil {
+		return err
+	}
+
+	ci := &CreateInputSet{
+		InputSet:   co.inputSet(),
+		Identifier: id,
+		Overlay:    overlay,
+		YAML:       b,
+	}
+
+	ci.Print(ci.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var newCommandExample = fmt.Sprintf(`
+# Create the input set of the pipeline from the YAML file
+%[1]s inputset new --pipeline my_pipeline --account-id <your account id> --project-id <project id> -f staging.yaml
+# Create the overlay input set of the pipeline, the YAML root is "overlayInputSet"
+%[1]s inputset new --pipeline my_pipeline --account-id <your account id> --project-id <project id> -f staging-overlay.yaml
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new input set or overlay input set from its YAML.",
+		Example: newCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*CreateInputSet)(nil)
@@ -0,0 +1,79 @@
+package inputset
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	inputSetsURL        = "https://app.harness.io/gateway/pipeline/api/inputSets"
+	inputSetURL         = "https://app.harness.io/gateway/pipeline/api/inputSets/{id}"
+	overlayInputSetsURL = "https://app.harness.io/gateway/pipeline/api/inputSets/overlay"
+	overlayInputSetURL  = "https://app.harness.io/gateway/pipeline/api/inputSets/overlay/{id}"
+)
+
+// PipelineOptions holds the project and the pipeline flags shared by the input set commands
+type PipelineOptions struct {
+	types.ScopeOptions
+	// The identifier of the pipeline of the input sets
+	Pipeline string
+}
+
+// AddFlags adds the "pipeline" and "project-id" flags to the command
+func (po *PipelineOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&po.Pipeline, "pipeline", "", "", "The identifier of the pipeline of the input set.")
+	cmd.MarkFlagRequired("pipeline")
+	po.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// InputSet holds the pipeline of the input set
+type InputSet struct {
+	types.ScopedResource
+	// The identifier of the pipeline
+	PipelineID string
+}
+
+// request builds the HTTP request scoped to the pipeline of the input set
+func (is *InputSet) request() *resty.Request {
+	req := is.Request()
+	req.SetQueryParam("pipelineIdentifier", is.PipelineID)
+	return req
+}
+
+// inputSet returns the InputSet of the pipeline
+func (po *PipelineOptions) inputSet() InputSet {
+	return InputSet{
+		ScopedResource: po.Resource(),
+		PipelineID:     po.Pipeline,
+	}
+}
+
+// readInputSet reads the input set YAML file, returns its content, identifier and
+// true when it is an overlay input set
+func readInputSet(file string) ([]byte, string, bool, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, "", false, err
+	}
+
+	root := "inputSet"
+	if _, ok := doc["overlayInputSet"]; ok {
+		root = "overlayInputSet"
+	} else if _, ok := doc[root]; !ok {
+		return nil, "", false, fmt.Errorf(`"%s" is not an input set YAML, missing the "inputSet" or "overlayInputSet" root`, file)
+	}
+
+	b, id, err := pipeline.ReadYAML(file, root)
+	return b, id, root == "overlayInputSet", err
+}
@@ -0,0 +1,99 @@
+package inputset
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type UpdateOptions struct {
+	PipelineOptions
+	// The input set or overlay input set YAML file
+	File string
+}
+
+// UpdateInputSet updates the input set or the overlay input set with its YAML
+type UpdateInputSet struct {
+	CreateInputSet
+}
+
+// Call implements types.RESTCall
+func (ui *UpdateInputSet) Call() (map[string]interface{}, error) {
+	req := ui.request()
+	req.SetPathParam("id", ui.Identifier)
+	if ui.Overlay {
+		return utils.PutYAML(req, overlayInputSetURL, ui.YAML)
+	}
+	return utils.PutYAML(req, inputSetURL, ui.YAML)
+}
+
+// Print implements types.RESTCall
+func (ui *UpdateInputSet) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Input set \"%s\" updated successfully\n", ui.Identifier)
+}
+
+// AddFlags implements types.Command
+func (uo *UpdateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&uo.File, "file", "f", "", "The input set or overlay input set YAML file, the input set is identified by its identifier.")
+	cmd.MarkFlagRequired("file")
+	uo.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (uo *UpdateOptions) Execute(cmd *cobra.Command, args []string) error {
+	b, id, overlay, err := readInputSet(uo.File)
+	if err != nil {
+		return err
+	}
+
+	ui := &UpdateInputSet{
+		CreateInputSet: CreateInputSet{
+			InputSet:   uo.inputSet(),
+			Identifier: id,
+			Overlay:    overlay,
+			YAML:       b,
+		},
+	}
+
+	ui.Print(ui.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (uo *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var updateCommandExample = fmt.Sprintf(`
+# Update the input set of the pipeline with the YAML file
+%[1]s inputset update --pipeline my_pipeline --account-id <your account id> --project-id <project id> -f staging.yaml
+`, common.ExamplePrefix())
+
+// newUpdateCommand instantiates the new instance of the newUpdateCommand
+func newUpdateCommand() *cobra.Command {
+	uo := &UpdateOptions{}
+
+	iCmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Updates the input set or overlay input set with its YAML.",
+		Example: updateCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(iCmd)
+
+	return iCmd
+}
+
+var _ types.Command = (*UpdateOptions)(nil)
+var _ types.RESTCall = (*UpdateInputSet)(nil)
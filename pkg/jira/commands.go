@@ -0,0 +1,34 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package jira
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewJiraConnectorCommands() *cobra.Command {
+	jCmd := &cobra.Command{
+		Use:              "jira",
+		Short:            "Group of commands to manipulate the Jira connectors.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	jCmd.AddCommand(newConnectorCommand())
+
+	return jCmd
+}
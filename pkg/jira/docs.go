@@ -0,0 +1,3 @@
+package jira
+
+// jira package defines the commands to manipulate Jira connector resource, used by the Jira approval and Jira create/update steps
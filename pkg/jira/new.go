@@ -0,0 +1,186 @@
+package jira
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/delegate"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	connectorType    = "Jira"
+	passwordAuthType = "UsernamePassword"
+	patAuthType      = "PersonalAccessToken"
+)
+
+type CreateOptions struct {
+	// password or pat
+	AuthenticationType string
+	Name               string
+	ProjectID          string
+	UserName           string
+	// The secret ID of the Jira API token, used with "password" authentication
+	APIToken string
+	// The secret ID of the Jira Personal Access Token, used with "pat" authentication
+	PersonalAccessToken string
+	Scope               string
+	URL                 string
+	DelegateSelectors   []string
+	CheckDelegateTags   bool
+}
+
+type UserNamePasswordAuth struct {
+	UserName string `json:"username"`
+	Password string `json:"passwordRef"`
+}
+
+type PATAuth struct {
+	PersonalAccessToken string `json:"patRef"`
+}
+
+type Authentication struct {
+	Type string      `json:"type"`
+	Spec interface{} `json:"spec"`
+}
+
+type Spec struct {
+	Authentication    Authentication `json:"auth"`
+	URL               string         `json:"jiraUrl"`
+	DelegateSelectors []string       `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the connector.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&co.URL, "url", "", "", "The Jira URL e.g. https://mycompany.atlassian.net")
+	cmd.MarkFlagRequired("url")
+	cmd.Flags().StringVarP(&co.AuthenticationType, "auth-type", "", "password", "The authentication type valid values are 'password' or 'pat' ")
+	cmd.Flags().StringVarP(&co.UserName, "username", "u", "", `The Jira user name. Required when "auth-type" is "password"`)
+	cmd.Flags().StringVarP(&co.APIToken, "api-token", "", "", `The ID of the Jira API token secret. Required when "auth-type" is "password". Use scope for finer access e.g. account.jiratoken, org.jiratoken etc.,`)
+	cmd.Flags().StringVarP(&co.PersonalAccessToken, "pat", "", "", `The ID of the Jira Personal Access Token(PAT) secret. Required when "auth-type" is "pat"`)
+	cmd.Flags().StringVarP(&co.ProjectID, "project-id", "p", "", `The project where the connector will be created.`)
+	cmd.Flags().StringVarP(&co.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
+	cmd.Flags().StringSliceVarP(&co.DelegateSelectors, "delegate-tags", "", []string{}, `The delegate tags that will be used to select the available delegate that will be used by the connector.`)
+	cmd.Flags().BoolVarP(&co.CheckDelegateTags, "check-delegate-tags", "", false, "Warn when none of the delegate tags match an existing delegate.")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	c := &types.Connector{
+		APIKey:     viper.GetString("api-key"),
+		AccountID:  viper.GetString("account-id"),
+		Name:       co.Name,
+		Identifier: utils.IDFromName(co.Name),
+		Type:       connectorType,
+		Scope:      co.Scope,
+	}
+
+	if co.Scope == "project" {
+		c.OrgID = viper.GetString("org-id")
+		c.ProjectID = co.ProjectID
+	} else if co.Scope == "org" {
+		c.OrgID = viper.GetString("org-id")
+	}
+
+	spec := &Spec{
+		URL: co.URL,
+	}
+
+	if co.AuthenticationType == "pat" {
+		spec.Authentication = Authentication{
+			Type: patAuthType,
+			Spec: PATAuth{
+				PersonalAccessToken: scopedName(co.Scope, co.PersonalAccessToken),
+			},
+		}
+	} else {
+		spec.Authentication = Authentication{
+			Type: passwordAuthType,
+			Spec: UserNamePasswordAuth{
+				UserName: co.UserName,
+				Password: scopedName(co.Scope, co.APIToken),
+			},
+		}
+	}
+
+	if len(co.DelegateSelectors) > 0 {
+		spec.DelegateSelectors = co.DelegateSelectors
+	}
+
+	c.Spec = spec
+
+	if co.CheckDelegateTags {
+		delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, co.DelegateSelectors)
+	}
+
+	ci := &types.ConnectorInfo{
+		ConnectorInfo: *c,
+	}
+
+	ci.Call()
+
+	return nil
+}
+
+func scopedName(scope, name string) string {
+	if scope == "account" {
+		return fmt.Sprintf("account.%s", name)
+	}
+
+	if scope == "org" {
+		return fmt.Sprintf("org.%s", name)
+	}
+
+	return name
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	switch co.AuthenticationType {
+	case "password":
+		if co.UserName == "" || co.APIToken == "" {
+			return fmt.Errorf(`"username" and "api-token" is required for password authentication`)
+		}
+	case "pat":
+		if co.PersonalAccessToken == "" {
+			return fmt.Errorf(`"pat" is required for pat authentication`)
+		}
+	default:
+		return fmt.Errorf(`"auth-type" should be one of "password" or "pat"`)
+	}
+
+	return nil
+}
+
+var newCommandExample = fmt.Sprintf(`
+# Create new Jira connector with username and API token
+%[1]s jira new --name jira --account-id <your account id> --project-id <project id> --url https://mycompany.atlassian.net --username foo@example.com --api-token jira-api-token
+# Create new Jira connector with Personal Access Token at account scope
+%[1]s jira new --name jira --account-id <your account id> --url https://jira.example.com --auth-type pat --pat jira-pat --connector-scope="account"
+`, common.ExamplePrefix())
+
+// newConnectorCommand instantiates the new instance of the newConnectorCommand
+func newConnectorCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	jCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new Jira connector if not exists.",
+		Example: newCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(jCmd)
+
+	return jCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
@@ -0,0 +1,104 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	appDynamicsConnectorType = "AppDynamics"
+	passwordAuthType         = "UsernamePassword"
+	apiClientAuthType        = "ApiClientToken"
+)
+
+type AppDynamicsOptions struct {
+	commonOptions
+	// password or api-client
+	AuthenticationType string
+	AccountName        string
+	UserName           string
+	Password           string
+	ClientID           string
+	ClientSecret       string
+}
+
+type AppDynamicsSpec struct {
+	ControllerURL     string   `json:"controllerUrl"`
+	AccountName       string   `json:"accountname"`
+	AuthType          string   `json:"authType"`
+	UserName          string   `json:"username,omitempty"`
+	PasswordRef       string   `json:"passwordRef,omitempty"`
+	ClientID          string   `json:"clientId,omitempty"`
+	ClientSecretRef   string   `json:"clientSecretRef,omitempty"`
+	DelegateSelectors []string `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (ao *AppDynamicsOptions) AddFlags(cmd *cobra.Command) {
+	ao.addFlags(cmd, "The AppDynamics controller URL e.g. https://mycompany.saas.appdynamics.com/controller/", "")
+	cmd.Flags().StringVarP(&ao.AccountName, "account-name", "", "", "The AppDynamics account name.")
+	cmd.MarkFlagRequired("account-name")
+	cmd.Flags().StringVarP(&ao.AuthenticationType, "auth-type", "", "password", "The authentication type valid values are 'password' or 'api-client' ")
+	cmd.Flags().StringVarP(&ao.UserName, "username", "u", "", `The AppDynamics user name. Required when "auth-type" is "password"`)
+	cmd.Flags().StringVarP(&ao.Password, "password", "", "", `The ID of the AppDynamics password secret. Required when "auth-type" is "password"`)
+	cmd.Flags().StringVarP(&ao.ClientID, "client-id", "", "", `The AppDynamics API client name. Required when "auth-type" is "api-client"`)
+	cmd.Flags().StringVarP(&ao.ClientSecret, "client-secret", "", "", `The ID of the AppDynamics API client secret secret. Required when "auth-type" is "api-client"`)
+}
+
+// Execute implements types.Command
+func (ao *AppDynamicsOptions) Execute(cmd *cobra.Command, args []string) error {
+	spec := &AppDynamicsSpec{
+		ControllerURL:     ao.URL,
+		AccountName:       ao.AccountName,
+		DelegateSelectors: ao.delegateSelectors(),
+	}
+
+	if ao.AuthenticationType == "api-client" {
+		spec.AuthType = apiClientAuthType
+		spec.ClientID = ao.ClientID
+		spec.ClientSecretRef = ao.scopedName(ao.ClientSecret)
+	} else {
+		spec.AuthType = passwordAuthType
+		spec.UserName = ao.UserName
+		spec.PasswordRef = ao.scopedName(ao.Password)
+	}
+
+	ao.create(appDynamicsConnectorType, spec)
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ao *AppDynamicsOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	switch ao.AuthenticationType {
+	case "password":
+		if ao.UserName == "" || ao.Password == "" {
+			return fmt.Errorf(`"username" and "password" is required for password authentication`)
+		}
+	case "api-client":
+		if ao.ClientID == "" || ao.ClientSecret == "" {
+			return fmt.Errorf(`"client-id" and "client-secret" is required for api-client authentication`)
+		}
+	default:
+		return fmt.Errorf(`"auth-type" should be one of "password" or "api-client"`)
+	}
+
+	return nil
+}
+
+func (ao *AppDynamicsOptions) example() string {
+	return fmt.Sprintf(`
+# Create new AppDynamics connector with username and password
+%[1]s monitoring appdynamics new --name appd --account-id <your account id> --project-id <project id> --url https://mycompany.saas.appdynamics.com/controller/ --account-name mycompany --username foo --password appd-password
+# Create new AppDynamics connector with API client
+%[1]s monitoring appdynamics new --name appd --account-id <your account id> --project-id <project id> --url https://mycompany.saas.appdynamics.com/controller/ --account-name mycompany --auth-type api-client --client-id harness --client-secret appd-client-secret
+`, common.ExamplePrefix())
+}
+
+var _ types.Command = (*AppDynamicsOptions)(nil)
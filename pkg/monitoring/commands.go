@@ -0,0 +1,41 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package monitoring
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewMonitoringConnectorCommands is parent for all the monitoring and observability connector commands
+func NewMonitoringConnectorCommands() *cobra.Command {
+	mCmd := &cobra.Command{
+		Use:              "monitoring",
+		Aliases:          []string{"mon"},
+		Short:            "Group of commands to manipulate the monitoring connectors used by Continuous Verification.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	mCmd.AddCommand(newProviderCommands("prometheus", "Prometheus", &PrometheusOptions{}))
+	mCmd.AddCommand(newProviderCommands("datadog", "Datadog", &DatadogOptions{}))
+	mCmd.AddCommand(newProviderCommands("newrelic", "New Relic", &NewRelicOptions{}))
+	mCmd.AddCommand(newProviderCommands("appdynamics", "AppDynamics", &AppDynamicsOptions{}))
+	mCmd.AddCommand(newProviderCommands("splunk", "Splunk", &SplunkOptions{}))
+	mCmd.AddCommand(newProviderCommands("dynatrace", "Dynatrace", &DynatraceOptions{}))
+
+	return mCmd
+}
@@ -0,0 +1,116 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/delegate"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// providerOptions is implemented by the create options of each monitoring provider
+type providerOptions interface {
+	types.Command
+	// example returns the command examples of the provider
+	example() string
+}
+
+// commonOptions holds the options that are shared by all the monitoring connectors
+type commonOptions struct {
+	Name              string
+	ProjectID         string
+	Scope             string
+	URL               string
+	DelegateSelectors []string
+	CheckDelegateTags bool
+}
+
+// addFlags adds the flags that are shared by all the monitoring connectors
+func (o *commonOptions) addFlags(cmd *cobra.Command, urlUsage, defaultURL string) {
+	cmd.Flags().StringVarP(&o.Name, "name", "n", "", "The name of the connector.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&o.URL, "url", "", defaultURL, urlUsage)
+	if defaultURL == "" {
+		cmd.MarkFlagRequired("url")
+	}
+	cmd.Flags().StringVarP(&o.ProjectID, "project-id", "p", "", `The project where the connector will be created.`)
+	cmd.Flags().StringVarP(&o.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
+	cmd.Flags().StringSliceVarP(&o.DelegateSelectors, "delegate-tags", "", []string{}, `The delegate tags that will be used to select the available delegate that will be used by the connector.`)
+	cmd.Flags().BoolVarP(&o.CheckDelegateTags, "check-delegate-tags", "", false, "Warn when none of the delegate tags match an existing delegate.")
+}
+
+// create builds the connector of type connectorType with the spec and creates it
+func (o *commonOptions) create(connectorType string, spec interface{}) {
+	c := &types.Connector{
+		APIKey:     viper.GetString("api-key"),
+		AccountID:  viper.GetString("account-id"),
+		Name:       o.Name,
+		Identifier: utils.IDFromName(o.Name),
+		Type:       connectorType,
+		Scope:      o.Scope,
+		Spec:       spec,
+	}
+
+	if o.Scope == "project" {
+		c.OrgID = viper.GetString("org-id")
+		c.ProjectID = o.ProjectID
+	} else if o.Scope == "org" {
+		c.OrgID = viper.GetString("org-id")
+	}
+
+	if o.CheckDelegateTags {
+		delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, o.DelegateSelectors)
+	}
+
+	ci := &types.ConnectorInfo{
+		ConnectorInfo: *c,
+	}
+
+	ci.Call()
+}
+
+// delegateSelectors returns nil when no delegate tags are set so that it is omitted from the spec
+func (o *commonOptions) delegateSelectors() []string {
+	if len(o.DelegateSelectors) > 0 {
+		return o.DelegateSelectors
+	}
+	return nil
+}
+
+// scopedName returns the secret reference for the secret name
+func (o *commonOptions) scopedName(name string) string {
+	if o.Scope == "account" {
+		return fmt.Sprintf("account.%s", name)
+	}
+
+	if o.Scope == "org" {
+		return fmt.Sprintf("org.%s", name)
+	}
+
+	return name
+}
+
+// newProviderCommands instantiates the command group of the provider with its "new" command
+func newProviderCommands(use, title string, po providerOptions) *cobra.Command {
+	pCmd := &cobra.Command{
+		Use:              use,
+		Short:            fmt.Sprintf("Group of commands to manipulate the %s connectors.", title),
+		TraverseChildren: true,
+	}
+
+	nCmd := &cobra.Command{
+		Use:     "new",
+		Short:   fmt.Sprintf("Creates a new %s connector if not exists.", title),
+		Example: po.example(),
+		RunE:    po.Execute,
+		PreRunE: po.Validate,
+	}
+
+	po.AddFlags(nCmd)
+
+	pCmd.AddCommand(nCmd)
+
+	return pCmd
+}
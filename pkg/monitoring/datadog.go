@@ -0,0 +1,63 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const datadogConnectorType = "Datadog"
+
+type DatadogOptions struct {
+	commonOptions
+	APIKey         string
+	ApplicationKey string
+}
+
+type DatadogSpec struct {
+	URL               string   `json:"url"`
+	APIKeyRef         string   `json:"apiKeyRef"`
+	ApplicationKeyRef string   `json:"applicationKeyRef"`
+	DelegateSelectors []string `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (do *DatadogOptions) AddFlags(cmd *cobra.Command) {
+	do.addFlags(cmd, "The Datadog API URL e.g. https://api.datadoghq.eu/api/", "https://api.datadoghq.com/api/")
+	cmd.Flags().StringVarP(&do.APIKey, "api-key-secret", "", "", "The ID of the Datadog API key secret.")
+	cmd.MarkFlagRequired("api-key-secret")
+	cmd.Flags().StringVarP(&do.ApplicationKey, "app-key-secret", "", "", "The ID of the Datadog application key secret.")
+	cmd.MarkFlagRequired("app-key-secret")
+}
+
+// Execute implements types.Command
+func (do *DatadogOptions) Execute(cmd *cobra.Command, args []string) error {
+	do.create(datadogConnectorType, &DatadogSpec{
+		URL:               do.URL,
+		APIKeyRef:         do.scopedName(do.APIKey),
+		ApplicationKeyRef: do.scopedName(do.ApplicationKey),
+		DelegateSelectors: do.delegateSelectors(),
+	})
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DatadogOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+func (do *DatadogOptions) example() string {
+	return fmt.Sprintf(`
+# Create new Datadog connector
+%[1]s monitoring datadog new --name datadog --account-id <your account id> --project-id <project id> --api-key-secret dd-api-key --app-key-secret dd-app-key
+# Create new Datadog connector for the EU site at account scope
+%[1]s monitoring datadog new --name datadog --account-id <your account id> --url https://api.datadoghq.eu/api/ --api-key-secret dd-api-key --app-key-secret dd-app-key --connector-scope="account"
+`, common.ExamplePrefix())
+}
+
+var _ types.Command = (*DatadogOptions)(nil)
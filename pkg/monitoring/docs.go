@@ -0,0 +1,4 @@
+package monitoring
+
+// monitoring package defines the commands to manipulate the monitoring connectors used by Continuous Verification(CV).
+// The package defines commands to create Prometheus, Datadog, New Relic, AppDynamics, Splunk and Dynatrace connectors.
@@ -0,0 +1,56 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const dynatraceConnectorType = "Dynatrace"
+
+type DynatraceOptions struct {
+	commonOptions
+	APIToken string
+}
+
+type DynatraceSpec struct {
+	URL               string   `json:"url"`
+	APITokenRef       string   `json:"apiTokenRef"`
+	DelegateSelectors []string `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (do *DynatraceOptions) AddFlags(cmd *cobra.Command) {
+	do.addFlags(cmd, "The Dynatrace environment URL e.g. https://<environment id>.live.dynatrace.com", "")
+	cmd.Flags().StringVarP(&do.APIToken, "api-token", "", "", "The ID of the Dynatrace API token secret.")
+	cmd.MarkFlagRequired("api-token")
+}
+
+// Execute implements types.Command
+func (do *DynatraceOptions) Execute(cmd *cobra.Command, args []string) error {
+	do.create(dynatraceConnectorType, &DynatraceSpec{
+		URL:               do.URL,
+		APITokenRef:       do.scopedName(do.APIToken),
+		DelegateSelectors: do.delegateSelectors(),
+	})
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DynatraceOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+func (do *DynatraceOptions) example() string {
+	return fmt.Sprintf(`
+# Create new Dynatrace connector
+%[1]s monitoring dynatrace new --name dynatrace --account-id <your account id> --project-id <project id> --url https://abc12345.live.dynatrace.com --api-token dynatrace-token
+`, common.ExamplePrefix())
+}
+
+var _ types.Command = (*DynatraceOptions)(nil)
@@ -0,0 +1,61 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const newRelicConnectorType = "NewRelic"
+
+type NewRelicOptions struct {
+	commonOptions
+	NewRelicAccountID string
+	APIKey            string
+}
+
+type NewRelicSpec struct {
+	NewRelicAccountID string   `json:"newRelicAccountId"`
+	URL               string   `json:"url"`
+	APIKeyRef         string   `json:"apiKeyRef"`
+	DelegateSelectors []string `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (no *NewRelicOptions) AddFlags(cmd *cobra.Command) {
+	no.addFlags(cmd, "The New Relic Insights API URL e.g. https://insights-api.eu.newrelic.com/", "https://insights-api.newrelic.com/")
+	cmd.Flags().StringVarP(&no.NewRelicAccountID, "newrelic-account-id", "", "", "The New Relic account ID.")
+	cmd.MarkFlagRequired("newrelic-account-id")
+	cmd.Flags().StringVarP(&no.APIKey, "api-key-secret", "", "", "The ID of the New Relic Insights query key secret.")
+	cmd.MarkFlagRequired("api-key-secret")
+}
+
+// Execute implements types.Command
+func (no *NewRelicOptions) Execute(cmd *cobra.Command, args []string) error {
+	no.create(newRelicConnectorType, &NewRelicSpec{
+		NewRelicAccountID: no.NewRelicAccountID,
+		URL:               no.URL,
+		APIKeyRef:         no.scopedName(no.APIKey),
+		DelegateSelectors: no.delegateSelectors(),
+	})
+
+	return nil
+}
+
+// Validate implements types.Command
+func (no *NewRelicOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+func (no *NewRelicOptions) example() string {
+	return fmt.Sprintf(`
+# Create new New Relic connector
+%[1]s monitoring newrelic new --name newrelic --account-id <your account id> --project-id <project id> --newrelic-account-id 1234567 --api-key-secret nr-query-key
+`, common.ExamplePrefix())
+}
+
+var _ types.Command = (*NewRelicOptions)(nil)
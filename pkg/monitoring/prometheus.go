@@ -0,0 +1,73 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const prometheusConnectorType = "Prometheus"
+
+type PrometheusOptions struct {
+	commonOptions
+	UserName string
+	Password string
+}
+
+type PrometheusSpec struct {
+	URL               string        `json:"url"`
+	UserName          string        `json:"username,omitempty"`
+	PasswordRef       string        `json:"passwordRef,omitempty"`
+	Headers           []interface{} `json:"headers"`
+	DelegateSelectors []string      `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (po *PrometheusOptions) AddFlags(cmd *cobra.Command) {
+	po.addFlags(cmd, "The Prometheus server URL e.g. http://prometheus.monitoring:9090/", "")
+	cmd.Flags().StringVarP(&po.UserName, "username", "u", "", "The Prometheus user name, if the server requires basic authentication.")
+	cmd.Flags().StringVarP(&po.Password, "password", "", "", "The ID of the Prometheus password secret, if the server requires basic authentication.")
+}
+
+// Execute implements types.Command
+func (po *PrometheusOptions) Execute(cmd *cobra.Command, args []string) error {
+	spec := &PrometheusSpec{
+		URL:               po.URL,
+		UserName:          po.UserName,
+		Headers:           []interface{}{},
+		DelegateSelectors: po.delegateSelectors(),
+	}
+
+	if po.Password != "" {
+		spec.PasswordRef = po.scopedName(po.Password)
+	}
+
+	po.create(prometheusConnectorType, spec)
+
+	return nil
+}
+
+// Validate implements types.Command
+func (po *PrometheusOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if (po.UserName == "") != (po.Password == "") {
+		return fmt.Errorf(`both "username" and "password" are required for basic authentication`)
+	}
+
+	return nil
+}
+
+func (po *PrometheusOptions) example() string {
+	return fmt.Sprintf(`
+# Create new Prometheus connector
+%[1]s monitoring prometheus new --name prometheus --account-id <your account id> --project-id <project id> --url http://prometheus.monitoring:9090/
+# Create new Prometheus connector with basic authentication
+%[1]s monitoring prometheus new --name prometheus --account-id <your account id> --project-id <project id> --url https://prometheus.example.com/ --username foo --password prometheus-password
+`, common.ExamplePrefix())
+}
+
+var _ types.Command = (*PrometheusOptions)(nil)
@@ -0,0 +1,61 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const splunkConnectorType = "Splunk"
+
+type SplunkOptions struct {
+	commonOptions
+	UserName string
+	Password string
+}
+
+type SplunkSpec struct {
+	SplunkURL         string   `json:"splunkUrl"`
+	UserName          string   `json:"username"`
+	PasswordRef       string   `json:"passwordRef"`
+	DelegateSelectors []string `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (so *SplunkOptions) AddFlags(cmd *cobra.Command) {
+	so.addFlags(cmd, "The Splunk REST API URL e.g. https://splunk.example.com:8089/", "")
+	cmd.Flags().StringVarP(&so.UserName, "username", "u", "", "The Splunk user name.")
+	cmd.MarkFlagRequired("username")
+	cmd.Flags().StringVarP(&so.Password, "password", "", "", "The ID of the Splunk password secret.")
+	cmd.MarkFlagRequired("password")
+}
+
+// Execute implements types.Command
+func (so *SplunkOptions) Execute(cmd *cobra.Command, args []string) error {
+	so.create(splunkConnectorType, &SplunkSpec{
+		SplunkURL:         so.URL,
+		UserName:          so.UserName,
+		PasswordRef:       so.scopedName(so.Password),
+		DelegateSelectors: so.delegateSelectors(),
+	})
+
+	return nil
+}
+
+// Validate implements types.Command
+func (so *SplunkOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+func (so *SplunkOptions) example() string {
+	return fmt.Sprintf(`
+# Create new Splunk connector
+%[1]s monitoring splunk new --name splunk --account-id <your account id> --project-id <project id> --url https://splunk.example.com:8089/ --username foo --password splunk-password
+`, common.ExamplePrefix())
+}
+
+var _ types.Command = (*SplunkOptions)(nil)
@@ -0,0 +1,3 @@
+package nexus
+
+// nexus package defines the commands to manipulate Sonatype Nexus 2.x and 3.x connector resource
@@ -0,0 +1,187 @@
+package nexus
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/delegate"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	connectorType     = "Nexus"
+	passwordAuthType  = "UsernamePassword"
+	anonymousAuthType = "Anonymous"
+)
+
+type CreateOptions struct {
+	// password or anonymous
+	AuthenticationType string
+	Name               string
+	ExecuteOnDelegate  bool
+	ProjectID          string
+	UserName           string
+	Password           string
+	Scope              string
+	URL                string
+	// "2.x" or "3.x"
+	Version           string
+	DelegateSelectors []string
+	CheckDelegateTags bool
+}
+
+type UserNamePasswordAuth struct {
+	UserName string `json:"username"`
+	Password string `json:"passwordRef"`
+}
+
+type Authentication struct {
+	Type string      `json:"type"`
+	Spec interface{} `json:"spec,omitempty"`
+}
+
+type Spec struct {
+	Authentication Authentication `json:"auth"`
+	URL            string         `json:"nexusServerUrl"`
+	// "2.x" or "3.x"
+	Version           string   `json:"version"`
+	ExecuteOnDelegate bool     `json:"executeOnDelegate"`
+	DelegateSelectors []string `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the connector.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&co.UserName, "username", "u", "", "The Nexus user name.")
+	cmd.Flags().StringVarP(&co.Password, "password", "", "", "The ID of the Nexus password secret. Use scope for finer access e.g. account.nexuspassword, org.nexuspassword etc.,")
+	cmd.Flags().StringVarP(&co.AuthenticationType, "auth-type", "", "password", "The authentication type valid values are 'password' or 'anonymous' ")
+	cmd.Flags().StringVarP(&co.URL, "url", "", "", "The Nexus server URL e.g. https://nexus.example.com")
+	cmd.MarkFlagRequired("url")
+	cmd.Flags().StringVarP(&co.Version, "nexus-version", "", "3.x", `The Nexus server version. Valid values are "2.x" "3.x"`)
+	cmd.Flags().StringVarP(&co.ProjectID, "project-id", "p", "", `The project where the connector will be created.`)
+	cmd.Flags().StringVarP(&co.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
+	cmd.Flags().BoolVarP(&co.ExecuteOnDelegate, "execute-on-delegate", "", true, "Allow the connector to execute on delegate.")
+	cmd.Flags().StringSliceVarP(&co.DelegateSelectors, "delegate-tags", "", []string{}, `The delegate tags that will be used to select the available delegate that will be used by the connector.`)
+	cmd.Flags().BoolVarP(&co.CheckDelegateTags, "check-delegate-tags", "", false, "Warn when none of the delegate tags match an existing delegate.")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	c := &types.Connector{
+		APIKey:     viper.GetString("api-key"),
+		AccountID:  viper.GetString("account-id"),
+		Name:       co.Name,
+		Identifier: utils.IDFromName(co.Name),
+		Type:       connectorType,
+		Scope:      co.Scope,
+	}
+
+	if co.Scope == "project" {
+		c.OrgID = viper.GetString("org-id")
+		c.ProjectID = co.ProjectID
+	} else if co.Scope == "org" {
+		c.OrgID = viper.GetString("org-id")
+	}
+
+	spec := &Spec{
+		URL:               co.URL,
+		Version:           co.Version,
+		ExecuteOnDelegate: co.ExecuteOnDelegate,
+	}
+
+	if co.AuthenticationType == "password" {
+		spec.Authentication = Authentication{
+			Type: passwordAuthType,
+			Spec: UserNamePasswordAuth{
+				UserName: co.UserName,
+				Password: scopedName(co.Scope, co.Password),
+			},
+		}
+	} else {
+		spec.Authentication = Authentication{
+			Type: anonymousAuthType,
+			Spec: nil,
+		}
+	}
+
+	if len(co.DelegateSelectors) > 0 {
+		spec.DelegateSelectors = co.DelegateSelectors
+	}
+
+	c.Spec = spec
+
+	if co.CheckDelegateTags {
+		delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, co.DelegateSelectors)
+	}
+
+	ci := &types.ConnectorInfo{
+		ConnectorInfo: *c,
+	}
+
+	ci.Call()
+
+	return nil
+}
+
+func scopedName(scope, name string) string {
+	if scope == "account" {
+		return fmt.Sprintf("account.%s", name)
+	}
+
+	if scope == "org" {
+		return fmt.Sprintf("org.%s", name)
+	}
+
+	return name
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if co.Version != "2.x" && co.Version != "3.x" {
+		return fmt.Errorf(`"nexus-version" should be one of "2.x" or "3.x"`)
+	}
+
+	switch co.AuthenticationType {
+	case "password":
+		if co.UserName == "" || co.Password == "" {
+			return fmt.Errorf(`"username" and "password" is required for password authentication`)
+		}
+	case "anonymous":
+	default:
+		return fmt.Errorf(`"auth-type" should be one of "password" or "anonymous"`)
+	}
+
+	return nil
+}
+
+var newCommandExample = fmt.Sprintf(`
+# Create new Nexus 3 connector with username and password with default options
+%[1]s nexus new --name foo --account-id <your account id> --project-id <project id> --url https://nexus.example.com --username foo --password foo-password
+# Create new Nexus 2 connector with anonymous access at org scope
+%[1]s nexus new --name foo --account-id <your account id> --url https://nexus.example.com --nexus-version 2.x --auth-type anonymous --org-id=<orgid> --connector-scope="org"
+`, common.ExamplePrefix())
+
+// newConnectorCommand instantiates the new instance of the newConnectorCommand
+func newConnectorCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	nCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new Nexus connector if not exists.",
+		Example: newCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(nCmd)
+
+	return nCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
@@ -0,0 +1,41 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package pipeline
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewPipelineCommands is parent for all the "pipeline" resource commands
+func NewPipelineCommands() *cobra.Command {
+	pCmd := &cobra.Command{
+		Use:              "pipeline",
+		Aliases:          []string{"pl"},
+		Short:            "Group of commands to manipulate the pipelines.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	pCmd.AddCommand(newCreateCommand())
+	pCmd.AddCommand(newGetCommand())
+	pCmd.AddCommand(newListCommand())
+	pCmd.AddCommand(newUpdateCommand())
+	pCmd.AddCommand(newRunCommand())
+	pCmd.AddCommand(newDeleteCommand())
+
+	return pCmd
+}
@@ -0,0 +1,88 @@
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// DeletePipeline deletes the pipeline
+type DeletePipeline struct {
+	types.ScopedResource
+	// The identifier of the pipeline
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (dp *DeletePipeline) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(dp.Request(), pipelineURL, dp.Identifier)
+}
+
+// Print implements types.RESTCall
+func (dp *DeletePipeline) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Pipeline \"%s\" deleted successfully\n", dp.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the pipeline to delete.")
+	cmd.MarkFlagRequired("name")
+	do.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	dp := &DeletePipeline{
+		ScopedResource: do.Resource(),
+		Identifier:     do.Name,
+	}
+
+	dp.Print(dp.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the pipeline
+%[1]s pipeline delete --name my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	pCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a pipeline.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(pCmd)
+
+	return pCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeletePipeline)(nil)
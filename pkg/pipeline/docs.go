@@ -0,0 +1,5 @@
+package pipeline
+
+// pipeline package has the commands that will be used to manipulate the Harness Pipelines of a project.
+// This package has commands to manage the pipelines from their YAML and to run them
+// Refer to https://apidocs.harness.io/tag/Pipeline-Setup and https://apidocs.harness.io/tag/Pipeline-Execute for API
@@ -0,0 +1,178 @@
+package pipeline
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+const executionURL = "https://app.harness.io/gateway/pipeline/api/pipelines/execution/v2/{id}"
+
+// terminalStatuses are the execution statuses after which the execution will not progress anymore
+var terminalStatuses = map[string]bool{
+	"Success":          true,
+	"Failed":           true,
+	"Aborted":          true,
+	"AbortedByFreeze":  true,
+	"Expired":          true,
+	"Errored":          true,
+	"IgnoreFailed":     true,
+	"ApprovalRejected": true,
+	"Skipped":          true,
+}
+
+// IsTerminalStatus returns true when the execution with the status has finished
+func IsTerminalStatus(status string) bool {
+	return terminalStatuses[status]
+}
+
+// ExecutionURL returns the URL of the execution in the Harness UI
+func ExecutionURL(r types.ScopedResource, module, pipelineID, executionID string) string {
+	return fmt.Sprintf("https://app.harness.io/ng/#/account/%s/%s/orgs/%s/projects/%s/pipelines/%s/executions/%s/pipeline",
+		r.AccountID, module, r.OrgID, r.ProjectID, pipelineID, executionID)
+}
+
+// executionID returns the identifier of the execution started by the pipeline
+func executionID(rm map[string]interface{}) (string, error) {
+	data, _ := rm["data"].(map[string]interface{})
+	pe, _ := data["planExecution"].(map[string]interface{})
+	if id, ok := pe["uuid"].(string); ok && id != "" {
+		return id, nil
+	}
+	return "", fmt.Errorf("no execution in the response %v", rm["data"])
+}
+
+// PrintExecution prints the ID and the URL of the execution started by the pipeline,
+// returns the execution ID or empty when the response is an error
+func PrintExecution(rm map[string]interface{}, err error, r types.ScopedResource, module, pipelineID string) string {
+	if types.PrintError(rm, err) {
+		return ""
+	}
+
+	executionID, err := executionID(rm)
+	if err != nil {
+		log.Errorf("%s", err)
+		return ""
+	}
+
+	fmt.Printf("Execution ID: %s\n", executionID)
+	fmt.Printf("Execution URL: %s\n", ExecutionURL(r, module, pipelineID, executionID))
+
+	return executionID
+}
+
+// ExecutionSummary gets the summary of the execution, when withGraph is true the
+// execution graph of all its stages is also returned
+func ExecutionSummary(r types.ScopedResource, executionID string, withGraph bool) (map[string]interface{}, error) {
+	req := r.Request()
+	if withGraph {
+		req.SetQueryParam("renderFullBottomGraph", "true")
+	}
+
+	rm, err := utils.GetResourceByID(req, executionURL, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return nil, fmt.Errorf(`unable to get the execution "%s", %v`, executionID, rm["message"])
+	}
+
+	return rm["data"].(map[string]interface{}), nil
+}
+
+// ExecutionStatus returns the status of the execution e.g. "Running", "Success"
+func ExecutionStatus(r types.ScopedResource, executionID string) (string, error) {
+	data, err := ExecutionSummary(r, executionID, false)
+	if err != nil {
+		return "", err
+	}
+
+	summary, ok := data["pipelineExecutionSummary"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf(`no summary in the response of the execution "%s"`, executionID)
+	}
+	status, _ := summary["status"].(string)
+
+	return status, nil
+}
+
+// WaitForStatus polls the execution status every interval until done returns true for the status,
+// an error is returned when the timeout elapsed
+func WaitForStatus(r types.ScopedResource, executionID string, timeout, interval time.Duration, done func(status string) bool) (string, error) {
+	deadline := time.Now().Add(timeout)
+	last := ""
+	for {
+		status, err := ExecutionStatus(r, executionID)
+		if err != nil {
+			log.Warnf("Unable to get execution %s status, %s", executionID, err)
+		} else if status != last {
+			log.Infof("Execution %s is %s", executionID, status)
+			last = status
+		}
+
+		if err == nil && done(status) {
+			return status, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return last, fmt.Errorf(`timed out after %s waiting for execution "%s", last status "%s"`, timeout, executionID, last)
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// WaitForExecution polls the execution status every interval until the execution reaches
+// a terminal status, an error is returned when the execution did not succeed or the timeout elapsed
+func WaitForExecution(r types.ScopedResource, executionID string, timeout, interval time.Duration) error {
+	status, err := WaitForStatus(r, executionID, timeout, interval, IsTerminalStatus)
+	if err != nil {
+		return err
+	}
+
+	if status != "Success" {
+		return fmt.Errorf(`execution "%s" finished with status "%s"`, executionID, status)
+	}
+
+	fmt.Printf("Execution \"%s\" finished with status \"%s\"\n", executionID, status)
+
+	return nil
+}
+
+// WaitOptions holds the flags to wait for an execution to finish
+type WaitOptions struct {
+	// Wait for the execution to finish
+	Wait bool
+	// How long to wait for the execution to finish
+	Timeout time.Duration
+	// How often to poll the execution status
+	Interval time.Duration
+}
+
+// AddFlags adds the "wait", "timeout" and "interval" flags to the command
+func (wo *WaitOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&wo.Wait, "wait", "w", false, "Wait for the execution to finish, the command fails when the execution did not succeed.")
+	cmd.Flags().DurationVarP(&wo.Timeout, "timeout", "", time.Hour, `How long to wait for the execution to finish. Used only with "wait"`)
+	cmd.Flags().DurationVarP(&wo.Interval, "interval", "", 10*time.Second, `How often to check the execution status. Used only with "wait"`)
+}
+
+// Validate checks the wait flags
+func (wo *WaitOptions) Validate() error {
+	if wo.Wait && (wo.Interval <= 0 || wo.Timeout <= 0) {
+		return fmt.Errorf(`"interval" and "timeout" should be greater than zero`)
+	}
+	return nil
+}
+
+// WaitFor waits for the execution when "wait" is set
+func (wo *WaitOptions) WaitFor(r types.ScopedResource, executionID string) error {
+	if !wo.Wait {
+		return nil
+	}
+	return WaitForExecution(r, executionID, wo.Timeout, wo.Interval)
+}
@@ -0,0 +1,89 @@
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// GetPipeline gets the pipeline YAML
+type GetPipeline struct {
+	types.ScopedResource
+	// The identifier of the pipeline
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (gp *GetPipeline) Call() (map[string]interface{}, error) {
+	return utils.GetResourceByID(gp.Request(), pipelineURL, gp.Identifier)
+}
+
+// Print implements types.RESTCall
+func (gp *GetPipeline) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	data := rm["data"].(map[string]interface{})
+	fmt.Print(data["yamlPipeline"])
+}
+
+// AddFlags implements types.Command
+func (gpo *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gpo.Name, "name", "n", "", "The identifier of the pipeline.")
+	cmd.MarkFlagRequired("name")
+	gpo.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (gpo *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gp := &GetPipeline{
+		ScopedResource: gpo.Resource(),
+		Identifier:     gpo.Name,
+	}
+
+	gp.Print(gp.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gpo *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the pipeline YAML
+%[1]s pipeline get --name my_pipeline --account-id <your account id> --project-id <project id>
+# Save the pipeline YAML to a file
+%[1]s pipeline get --name my_pipeline --account-id <your account id> --project-id <project id> > pipeline.yaml
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gpo := &GetOptions{}
+
+	pCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets the pipeline YAML.",
+		Example: getCommandExample,
+		RunE:    gpo.Execute,
+		PreRunE: gpo.Validate,
+	}
+
+	gpo.AddFlags(pCmd)
+
+	return pCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetPipeline)(nil)
@@ -0,0 +1,150 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+	// The tags that will be used to filter the pipelines, in the format of key:value
+	Tags []string
+	// The module that will be used to filter the pipelines e.g. "cd", "ci"
+	Module string
+	// The git repository that will be used to filter the remote pipelines
+	Repo string
+}
+
+// ListPipelines lists the pipelines of the project
+type ListPipelines struct {
+	types.ScopedResource
+	// The module that will be used to filter the pipelines e.g. "cd", "ci"
+	Module string `json:"-"`
+	// The git repository that will be used to filter the remote pipelines
+	Repo string `json:"-"`
+	// Always "PipelineSetup"
+	FilterType string `json:"filterType"`
+	// The tags that will be used to filter the pipelines
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Call implements types.RESTCall
+func (lp *ListPipelines) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lp.Request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		if lp.Module != "" {
+			req.SetQueryParam("module", lp.Module)
+		}
+		if lp.Repo != "" {
+			req.SetQueryParam("repoName", lp.Repo)
+		}
+		return utils.PostJSON(req, pipelineListURL, lp)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lp *ListPipelines) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			p := c.(map[string]interface{})
+			res := map[string]interface{}{
+				"name":      p["name"],
+				"id":        p["identifier"],
+				"tags":      p["tags"],
+				"modules":   p["modules"],
+				"storeType": p["storeType"],
+			}
+			if gd, ok := p["gitDetails"].(map[string]interface{}); ok && gd["repoName"] != nil {
+				res["repo"] = gd["repoName"]
+			}
+			resMap = append(resMap, res)
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVarP(&lo.Tags, "tags", "t", []string{}, "The tags to filter the pipelines, in the format of key:value e.g. foo:bar.")
+	cmd.Flags().StringVarP(&lo.Module, "module", "m", "", `The module to filter the pipelines. Valid values are "cd" "ci" "cf" "cv" "sto" "chaos"`)
+	cmd.Flags().StringVarP(&lo.Repo, "repo", "", "", "The git repository name to filter the pipelines that are stored in git.")
+	lo.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lp := &ListPipelines{
+		ScopedResource: lo.Resource(),
+		Module:         strings.ToLower(lo.Module),
+		Repo:           lo.Repo,
+		FilterType:     "PipelineSetup",
+	}
+
+	if len(lo.Tags) > 0 {
+		lp.Tags = utils.TagMapFromStringArray(lo.Tags)
+	}
+
+	lp.Print(lp.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	for _, t := range lo.Tags {
+		if !strings.Contains(t, ":") {
+			return fmt.Errorf("tags should be of format 'key:value'")
+		}
+	}
+
+	return nil
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List all the pipelines of the project
+%[1]s pipeline list --account-id <your account id> --project-id <project id>
+# List the CD pipelines tagged with "team:payments"
+%[1]s pipeline list --account-id <your account id> --project-id <project id> --module cd --tags team:payments
+# List the pipelines stored in the git repository "pipelines"
+%[1]s pipeline list --account-id <your account id> --project-id <project id> --repo pipelines
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	pCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the pipelines of the project.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(pCmd)
+
+	return pCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListPipelines)(nil)
@@ -0,0 +1,95 @@
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	// The pipeline YAML file
+	File string
+}
+
+// Pipeline creates the pipeline from its YAML
+type Pipeline struct {
+	types.ScopedResource
+	// The identifier of the pipeline
+	Identifier string
+	// The pipeline YAML
+	YAML []byte
+}
+
+// Call implements types.RESTCall
+func (p *Pipeline) Call() (map[string]interface{}, error) {
+	return utils.PostYAML(p.Request(), pipelinesV2URL, p.YAML)
+}
+
+// Print implements types.RESTCall
+func (p *Pipeline) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Pipeline \"%s\" created successfully\n", p.Identifier)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", "The pipeline YAML file.")
+	cmd.MarkFlagRequired("file")
+	co.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	b, id, err := ReadYAML(co.File, "pipeline")
+	if err != nil {
+		return err
+	}
+
+	p := &Pipeline{
+		ScopedResource: co.Resource(),
+		Identifier:     id,
+		YAML:           b,
+	}
+
+	p.Print(p.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var newCommandExample = fmt.Sprintf(`
+# Create the pipeline from the YAML file
+%[1]s pipeline new --account-id <your account id> --project-id <project id> -f pipeline.yaml
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	pCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new pipeline from its YAML.",
+		Example: newCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(pCmd)
+
+	return pCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*Pipeline)(nil)
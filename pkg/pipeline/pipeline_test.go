@@ -0,0 +1,78 @@
+package pipeline
+
+import (
+	"testing"
+)
+
+func TestSetVariables(t *testing.T) {
+	inputs := `pipeline:
+  identifier: my_pipeline
+  variables:
+    - name: version
+      type: String
+      value: <+input>
+    - name: env
+      type: String
+      value: <+input>
+`
+
+	tests := []struct {
+		name    string
+		inputs  string
+		set     []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "set variables",
+			inputs: inputs,
+			set:    []string{"version=1.0.0", "env=dev=us"},
+			want: `pipeline:
+    identifier: my_pipeline
+    variables:
+        - name: version
+          type: String
+          value: 1.0.0
+        - name: env
+          type: String
+          value: dev=us
+`,
+		},
+		{
+			name:    "unknown variable",
+			inputs:  inputs,
+			set:     []string{"foo=bar"},
+			wantErr: true,
+		},
+		{
+			name:    "no variables",
+			inputs:  "pipeline:\n  identifier: my_pipeline\n",
+			set:     []string{"version=1.0.0"},
+			wantErr: true,
+		},
+		{
+			name:    "empty inputs",
+			inputs:  "",
+			set:     []string{"version=1.0.0"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid inputs",
+			inputs:  "pipeline: [",
+			set:     []string{"version=1.0.0"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := setVariables(tt.inputs, tt.set)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
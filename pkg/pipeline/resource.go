@@ -0,0 +1,23 @@
+package pipeline
+
+import (
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+)
+
+const (
+	pipelineURL     = "https://app.harness.io/gateway/pipeline/api/pipelines/{id}"
+	pipelinesV2URL  = "https://app.harness.io/gateway/pipeline/api/pipelines/v2"
+	pipelineV2URL   = "https://app.harness.io/gateway/pipeline/api/pipelines/v2/{id}"
+	pipelineListURL = "https://app.harness.io/gateway/pipeline/api/pipelines/list"
+)
+
+// ReadYAML reads the YAML file and returns its content with the identifier
+// of the resource under the root key e.g. "pipeline"
+func ReadYAML(file, root string) ([]byte, string, error) {
+	b, r, err := utils.ReadYAMLResource(file, root)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return b, utils.StringValue(r, "identifier"), nil
+}
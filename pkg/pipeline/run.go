@@ -0,0 +1,257 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	executeURL          = "https://app.harness.io/gateway/pipeline/api/pipeline/execute/{id}"
+	inputSetMergeURL    = "https://app.harness.io/gateway/pipeline/api/inputSets/merge"
+	inputSetTemplateURL = "https://app.harness.io/gateway/pipeline/api/inputSets/template"
+)
+
+type RunOptions struct {
+	types.ScopeOptions
+	WaitOptions
+	// The identifier of the pipeline to run
+	Name string
+	// The identifiers of the input sets to use
+	InputSets []string
+	// The pipeline variables to set, in the format of key=value
+	Set []string
+	// The git branch of the pipeline, used with pipelines stored in git
+	Branch string
+	// The module of the pipeline e.g. "ci", "cd"
+	Module string
+}
+
+// RunPipeline starts a new execution of the pipeline
+type RunPipeline struct {
+	types.ScopedResource
+	// The identifier of the pipeline
+	Identifier string
+	// The git branch of the pipeline
+	Branch string
+	// The module of the pipeline
+	Module string
+	// The runtime inputs YAML
+	InputsYAML []byte
+	// The identifier of the started execution
+	ExecutionID string
+}
+
+// Call implements types.RESTCall
+func (rp *RunPipeline) Call() (map[string]interface{}, error) {
+	req := rp.Request()
+	req.SetPathParam("id", rp.Identifier)
+	req.SetQueryParam("moduleType", rp.Module)
+	if rp.Branch != "" {
+		req.SetQueryParam("branch", rp.Branch)
+	}
+	return utils.PostYAML(req, executeURL, rp.InputsYAML)
+}
+
+// Print implements types.RESTCall
+func (rp *RunPipeline) Print(rm map[string]interface{}, err error) {
+	rp.ExecutionID = PrintExecution(rm, err, rp.ScopedResource, rp.Module, rp.Identifier)
+}
+
+// inputsYAML builds the runtime inputs YAML from the input sets and the variables
+func (ro *RunOptions) inputsYAML(r types.ScopedResource, pipelineID string) ([]byte, error) {
+	var inputs string
+	var err error
+	if len(ro.InputSets) > 0 {
+		inputs, err = MergeInputSets(r, pipelineID, ro.Branch, ro.InputSets)
+	} else if len(ro.Set) > 0 {
+		inputs, err = ro.inputsTemplate(r, pipelineID)
+	}
+
+	if err != nil || len(ro.Set) == 0 {
+		return []byte(inputs), err
+	}
+
+	return setVariables(inputs, ro.Set)
+}
+
+// MergeInputSets merges the input sets of the pipeline in to a single runtime inputs YAML,
+// the input sets are merged in the order of the identifiers
+func MergeInputSets(r types.ScopedResource, pipelineID, branch string, ids []string) (string, error) {
+	req := r.Request()
+	req.SetQueryParam("pipelineIdentifier", pipelineID)
+	if branch != "" {
+		req.SetQueryParam("branch", branch)
+	}
+
+	rm, err := utils.PostJSON(req, inputSetMergeURL, map[string]interface{}{
+		"inputSetReferences": ids,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return "", fmt.Errorf("unable to merge the input sets %v, %v", ids, rm["message"])
+	}
+
+	data, _ := rm["data"].(map[string]interface{})
+	if v, ok := data["isErrorResponse"].(bool); ok && v {
+		return "", fmt.Errorf("unable to merge the input sets %v, %v", ids, data["inputSetErrorWrapper"])
+	}
+
+	inputs, ok := data["pipelineYaml"].(string)
+	if !ok {
+		return "", fmt.Errorf("unable to merge the input sets %v, no pipeline YAML in the response", ids)
+	}
+
+	return inputs, nil
+}
+
+// inputsTemplate returns the runtime inputs template YAML of the pipeline
+func (ro *RunOptions) inputsTemplate(r types.ScopedResource, pipelineID string) (string, error) {
+	req := r.Request()
+	req.SetQueryParam("pipelineIdentifier", pipelineID)
+	if ro.Branch != "" {
+		req.SetQueryParam("branch", ro.Branch)
+	}
+
+	rm, err := utils.PostJSON(req, inputSetTemplateURL, map[string]interface{}{})
+	if err != nil {
+		return "", err
+	}
+
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return "", fmt.Errorf(`unable to get the runtime inputs of the pipeline "%s", %v`, pipelineID, rm["message"])
+	}
+
+	data, _ := rm["data"].(map[string]interface{})
+	t, _ := data["inputSetTemplateYaml"].(string)
+	if t == "" {
+		return "", fmt.Errorf(`pipeline "%s" has no runtime inputs to set`, pipelineID)
+	}
+
+	return t, nil
+}
+
+// setVariables sets the values of the pipeline variables in the runtime inputs YAML
+func setVariables(inputs string, set []string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(inputs), &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("runtime inputs YAML is empty")
+	}
+
+	variables := utils.MappingValue(utils.MappingValue(doc.Content[0], "pipeline"), "variables")
+	if variables == nil || variables.Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("pipeline has no runtime input variables")
+	}
+
+	for _, kv := range set {
+		k, v, _ := strings.Cut(kv, "=")
+		found := false
+		for _, n := range variables.Content {
+			if name := utils.MappingValue(n, "name"); name != nil && name.Value == k {
+				if value := utils.MappingValue(n, "value"); value != nil {
+					value.SetString(v)
+					found = true
+				}
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf(`"%s" is not a runtime input variable of the pipeline`, k)
+		}
+	}
+
+	return yaml.Marshal(&doc)
+}
+
+// AddFlags implements types.Command
+func (ro *RunOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&ro.Name, "name", "n", "", "The identifier of the pipeline to run.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringSliceVarP(&ro.InputSets, "input-set", "i", []string{}, "The identifiers of the input sets to use, the input sets are merged in the order they are specified.")
+	cmd.Flags().StringArrayVarP(&ro.Set, "set", "s", []string{}, "The pipeline variable to set, in the format of key=value e.g. version=1.0.0. Overrides the values from the input sets.")
+	cmd.Flags().StringVarP(&ro.Branch, "branch", "b", "", "The git branch of the pipeline, used only with the pipelines stored in git.")
+	cmd.Flags().StringVarP(&ro.Module, "module", "m", "ci", `The module of the pipeline. Valid values are "ci" "cd" "cf" "sto" "chaos"`)
+	ro.ScopeOptions.AddProjectFlags(cmd)
+	ro.WaitOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (ro *RunOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	rp := &RunPipeline{
+		ScopedResource: ro.Resource(),
+		Identifier:     ro.Name,
+		Branch:         ro.Branch,
+		Module:         strings.ToLower(ro.Module),
+	}
+
+	inputs, err := ro.inputsYAML(rp.ScopedResource, rp.Identifier)
+	if err != nil {
+		return err
+	}
+	rp.InputsYAML = inputs
+
+	rp.Print(rp.Call())
+
+	if rp.ExecutionID == "" {
+		return fmt.Errorf(`unable to run the pipeline "%s"`, rp.Identifier)
+	}
+
+	return ro.WaitFor(rp.ScopedResource, rp.ExecutionID)
+}
+
+// Validate implements types.Command
+func (ro *RunOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	for _, kv := range ro.Set {
+		if k, _, ok := strings.Cut(kv, "="); !ok || k == "" {
+			return fmt.Errorf("set should be of format 'key=value'")
+		}
+	}
+
+	return ro.WaitOptions.Validate()
+}
+
+var runCommandExample = fmt.Sprintf(`
+# Run the pipeline
+%[1]s pipeline run --name my_pipeline --account-id <your account id> --project-id <project id>
+# Run the pipeline with the input sets "defaults" and "staging" on the branch "main"
+%[1]s pipeline run --name my_pipeline --account-id <your account id> --project-id <project id> --input-set defaults,staging --branch main
+# Run the pipeline setting the pipeline variable "version" and wait for it to finish
+%[1]s pipeline run --name my_pipeline --account-id <your account id> --project-id <project id> --set version=1.0.0 --wait
+`, common.ExamplePrefix())
+
+// newRunCommand instantiates the new instance of the newRunCommand
+func newRunCommand() *cobra.Command {
+	ro := &RunOptions{}
+
+	pCmd := &cobra.Command{
+		Use:     "run",
+		Short:   "Runs the pipeline and prints the execution ID and URL.",
+		Example: runCommandExample,
+		RunE:    ro.Execute,
+		PreRunE: ro.Validate,
+	}
+
+	ro.AddFlags(pCmd)
+
+	return pCmd
+}
+
+var _ types.Command = (*RunOptions)(nil)
+var _ types.RESTCall = (*RunPipeline)(nil)
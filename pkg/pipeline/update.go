@@ -0,0 +1,95 @@
+package pipeline
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type UpdateOptions struct {
+	types.ScopeOptions
+	// The pipeline YAML file
+	File string
+}
+
+// UpdatePipeline updates the pipeline with its YAML
+type UpdatePipeline struct {
+	Pipeline
+}
+
+// Call implements types.RESTCall
+func (up *UpdatePipeline) Call() (map[string]interface{}, error) {
+	req := up.Request()
+	req.SetPathParam("id", up.Identifier)
+	return utils.PutYAML(req, pipelineV2URL, up.YAML)
+}
+
+// Print implements types.RESTCall
+func (up *UpdatePipeline) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Pipeline \"%s\" updated successfully\n", up.Identifier)
+}
+
+// AddFlags implements types.Command
+func (uo *UpdateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&uo.File, "file", "f", "", "The pipeline YAML file, the pipeline is identified by its identifier.")
+	cmd.MarkFlagRequired("file")
+	uo.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// Execute implements types.Command
+func (uo *UpdateOptions) Execute(cmd *cobra.Command, args []string) error {
+	b, id, err := ReadYAML(uo.File, "pipeline")
+	if err != nil {
+		return err
+	}
+
+	up := &UpdatePipeline{
+		Pipeline: Pipeline{
+			ScopedResource: uo.Resource(),
+			Identifier:     id,
+			YAML:           b,
+		},
+	}
+
+	up.Print(up.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (uo *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var updateCommandExample = fmt.Sprintf(`
+# Update the pipeline with the YAML file
+%[1]s pipeline update --account-id <your account id> --project-id <project id> -f pipeline.yaml
+`, common.ExamplePrefix())
+
+// newUpdateCommand instantiates the new instance of the newUpdateCommand
+func newUpdateCommand() *cobra.Command {
+	uo := &UpdateOptions{}
+
+	pCmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Updates the pipeline with its YAML.",
+		Example: updateCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(pCmd)
+
+	return pCmd
+}
+
+var _ types.Command = (*UpdateOptions)(nil)
+var _ types.RESTCall = (*UpdatePipeline)(nil)
@@ -0,0 +1,352 @@
+package role
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/user"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// principalTypes maps the principal type flag values to the access control principal types
+var principalTypes = map[string]string{
+	"user":           "USER",
+	"usergroup":      "USER_GROUP",
+	"serviceaccount": "SERVICE_ACCOUNT",
+}
+
+type AssignmentOptions struct {
+	types.ScopeOptions
+	// The identifier of the role, used with "new"
+	Role string
+	// The identifier of the resource group, used with "new"
+	ResourceGroup string
+	// The email of the user or the identifier of the user group or service account, used with "new"
+	Principal string
+	// "user", "usergroup" or "serviceaccount", used with "new"
+	PrincipalType string
+	// The scope of the principal when it is not the scope of the assignment, used with "new"
+	PrincipalScope string
+	// The identifier of the role assignment, used with "delete"
+	ID string
+	// "new" or "delete"
+	action string
+}
+
+type AssignmentListOptions struct {
+	types.ScopeOptions
+	// The identifier of the role to filter the role assignments
+	Role string
+	// "user", "usergroup" or "serviceaccount" to filter the role assignments
+	PrincipalType string
+}
+
+// RoleAssignment binds the principal to the role and the resource group
+type RoleAssignment struct {
+	types.ScopedResource
+	ResourceGroupIdentifier string    `json:"resourceGroupIdentifier"`
+	RoleIdentifier          string    `json:"roleIdentifier"`
+	Principal               Principal `json:"principal"`
+	Disabled                bool      `json:"disabled"`
+	Managed                 bool      `json:"managed"`
+}
+
+// Principal is the user, user group or service account of the role assignment
+type Principal struct {
+	Identifier string `json:"identifier"`
+	// "USER", "USER_GROUP" or "SERVICE_ACCOUNT"
+	Type string `json:"type"`
+	// The scope level of the principal "account", "organization" or "project"
+	ScopeLevel string `json:"scopeLevel,omitempty"`
+}
+
+// DeleteRoleAssignment deletes the role assignment
+type DeleteRoleAssignment struct {
+	types.ScopedResource
+	// The identifier of the role assignment
+	Identifier string
+}
+
+// ListRoleAssignments lists the role assignments of the scope
+type ListRoleAssignments struct {
+	types.ScopedResource
+	// The identifiers of the roles to filter the role assignments
+	RoleFilter []string `json:"roleFilter,omitempty"`
+	// The principal types to filter the role assignments
+	PrincipalTypeFilter []string `json:"principalTypeFilter,omitempty"`
+}
+
+// Call implements types.RESTCall
+func (ra *RoleAssignment) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(ra.Request(), roleAssignmentsURL, ra)
+}
+
+// Print implements types.RESTCall
+func (ra *RoleAssignment) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	var id interface{}
+	data, _ := rm["data"].(map[string]interface{})
+	if a, ok := data["roleAssignment"].(map[string]interface{}); ok {
+		id = a["identifier"]
+	}
+	fmt.Printf("Role \"%s\" assigned to %s \"%s\" successfully with ID \"%v\"\n",
+		ra.RoleIdentifier, strings.ToLower(ra.Principal.Type), ra.Principal.Identifier, id)
+}
+
+// Call implements types.RESTCall
+func (dr *DeleteRoleAssignment) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(dr.Request(), roleAssignmentURL, dr.Identifier)
+}
+
+// Print implements types.RESTCall
+func (dr *DeleteRoleAssignment) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Role assignment \"%s\" deleted successfully\n", dr.Identifier)
+}
+
+// Call implements types.RESTCall
+func (lr *ListRoleAssignments) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lr.Request()
+		req.SetQueryParam("pageIndex", fmt.Sprint(index))
+		req.SetQueryParam("pageSize", "100")
+		return utils.PostJSON(req, roleAssignmentsFilterURL, lr)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lr *ListRoleAssignments) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data, _ := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			rr, _ := c.(map[string]interface{})
+			ra, ok := rr["roleAssignment"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			p, _ := ra["principal"].(map[string]interface{})
+			resMap = append(resMap, map[string]interface{}{
+				"id":            ra["identifier"],
+				"role":          ra["roleIdentifier"],
+				"resourceGroup": ra["resourceGroupIdentifier"],
+				"principal":     p["identifier"],
+				"principalType": p["type"],
+				"disabled":      ra["disabled"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// principalID returns the identifier of the principal, the users are found by their email in the account
+func (ao *AssignmentOptions) principalID(r types.ScopedResource) (string, error) {
+	if ao.PrincipalType != "user" {
+		return ao.Principal, nil
+	}
+	return user.FindUserID(types.ScopedResource{
+		APIKey:    r.APIKey,
+		AccountID: r.AccountID,
+		Scope:     "account",
+	}, ao.Principal)
+}
+
+// AddFlags implements types.Command
+func (ao *AssignmentOptions) AddFlags(cmd *cobra.Command) {
+	switch ao.action {
+	case "new":
+		cmd.Flags().StringVarP(&ao.Role, "role", "r", "", `The identifier of the role e.g. "_project_viewer".`)
+		cmd.MarkFlagRequired("role")
+		cmd.Flags().StringVarP(&ao.Principal, "principal", "", "", "The email of the user or the identifier of the user group or service account.")
+		cmd.MarkFlagRequired("principal")
+		cmd.Flags().StringVarP(&ao.PrincipalType, "principal-type", "", "user", `The type of the principal. Valid value is one of "user", "usergroup", "serviceaccount"`)
+		cmd.Flags().StringVarP(&ao.PrincipalScope, "principal-scope", "", "", `The scope of the user group or service account when it is inherited from a parent scope. Valid value is one of "account", "org"`)
+		cmd.Flags().StringVarP(&ao.ResourceGroup, "resource-group", "", "", "The identifier of the resource group, defaults to all the resources of the scope.")
+	default:
+		cmd.Flags().StringVarP(&ao.ID, "id", "", "", "The identifier of the role assignment to delete.")
+		cmd.MarkFlagRequired("id")
+	}
+	ao.ScopeOptions.AddFlags(cmd, "role")
+}
+
+// Execute implements types.Command
+func (ao *AssignmentOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	r := ao.Resource()
+	if ao.action == "delete" {
+		dr := &DeleteRoleAssignment{
+			ScopedResource: r,
+			Identifier:     ao.ID,
+		}
+		dr.Print(dr.Call())
+		return nil
+	}
+
+	id, err := ao.principalID(r)
+	if err != nil {
+		return err
+	}
+
+	ra := &RoleAssignment{
+		ScopedResource:          r,
+		ResourceGroupIdentifier: ao.ResourceGroup,
+		RoleIdentifier:          ao.Role,
+		Principal: Principal{
+			Identifier: id,
+			Type:       principalTypes[ao.PrincipalType],
+		},
+	}
+	if ra.ResourceGroupIdentifier == "" {
+		ra.ResourceGroupIdentifier = user.DefaultResourceGroup(ao.Scope)
+	}
+	if ao.PrincipalScope != "" {
+		ra.Principal.ScopeLevel = scopeLevel(ao.PrincipalScope)
+	}
+
+	rm, err := ra.Call()
+	ra.Print(rm, err)
+	if err != nil || rm["status"] != "SUCCESS" {
+		return fmt.Errorf(`unable to assign the role "%s" to "%s"`, ao.Role, ao.Principal)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ao *AssignmentOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if ao.action == "new" {
+		if _, ok := principalTypes[ao.PrincipalType]; !ok {
+			return fmt.Errorf(`"principal-type" should be one of "user", "usergroup" or "serviceaccount"`)
+		}
+		if ao.PrincipalScope != "" && ao.PrincipalScope != "account" && ao.PrincipalScope != "org" {
+			return fmt.Errorf(`"principal-scope" should be one of "account" or "org"`)
+		}
+	}
+
+	return ao.ScopeOptions.Validate()
+}
+
+// AddFlags implements types.Command
+func (alo *AssignmentListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&alo.Role, "role", "r", "", "The identifier of the role to filter the role assignments.")
+	cmd.Flags().StringVarP(&alo.PrincipalType, "principal-type", "", "", `The type of the principal to filter the role assignments. Valid value is one of "user", "usergroup", "serviceaccount"`)
+	alo.ScopeOptions.AddFlags(cmd, "role")
+}
+
+// Execute implements types.Command
+func (alo *AssignmentListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lr := &ListRoleAssignments{
+		ScopedResource: alo.Resource(),
+	}
+	if alo.Role != "" {
+		lr.RoleFilter = []string{alo.Role}
+	}
+	if alo.PrincipalType != "" {
+		lr.PrincipalTypeFilter = []string{principalTypes[alo.PrincipalType]}
+	}
+
+	lr.Print(lr.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (alo *AssignmentListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if _, ok := principalTypes[alo.PrincipalType]; alo.PrincipalType != "" && !ok {
+		return fmt.Errorf(`"principal-type" should be one of "user", "usergroup" or "serviceaccount"`)
+	}
+
+	return alo.ScopeOptions.Validate()
+}
+
+var assignmentCommandExamples = map[string]string{
+	"new": fmt.Sprintf(`
+# Assign the built-in role to the user for all the resources of the project
+%[1]s role assignment new --role _project_viewer --principal alice@example.com --account-id <your account id> --project-id <project id>
+# Assign the custom role to the account user group for the resource group of the project
+%[1]s role assignment new --role pipeline_runner --principal platform --principal-type usergroup --principal-scope account --resource-group prod_pipelines --account-id <your account id> --project-id <project id>
+# Assign the role to the service account at the account scope
+%[1]s role assignment new --role _account_viewer --principal ci_bot --principal-type serviceaccount --account-id <your account id> --role-scope account
+`, common.ExamplePrefix()),
+	"list": fmt.Sprintf(`
+# List the role assignments of the project
+%[1]s role assignment list --account-id <your account id> --project-id <project id>
+# List the user group assignments of the role
+%[1]s role assignment list --role pipeline_runner --principal-type usergroup --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"delete": fmt.Sprintf(`
+# Delete the role assignment
+%[1]s role assignment delete --id <role assignment id> --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+}
+
+var assignmentCommandShorts = map[string]string{
+	"new":    "Assigns a role and a resource group to a user, user group or service account.",
+	"list":   "Lists the role assignments.",
+	"delete": "Deletes a role assignment.",
+}
+
+// newAssignmentCommands instantiates the "role assignment" command with its sub commands
+func newAssignmentCommands() *cobra.Command {
+	aCmd := &cobra.Command{
+		Use:              "assignment",
+		Aliases:          []string{"ra"},
+		Short:            "Group of commands to manipulate the role assignments.",
+		TraverseChildren: true,
+	}
+
+	for _, action := range []string{"new", "delete"} {
+		ao := &AssignmentOptions{action: action}
+		cmd := &cobra.Command{
+			Use:     action,
+			Short:   assignmentCommandShorts[action],
+			Example: assignmentCommandExamples[action],
+			RunE:    ao.Execute,
+			PreRunE: ao.Validate,
+		}
+		ao.AddFlags(cmd)
+		aCmd.AddCommand(cmd)
+	}
+
+	alo := &AssignmentListOptions{}
+	lCmd := &cobra.Command{
+		Use:     "list",
+		Short:   assignmentCommandShorts["list"],
+		Example: assignmentCommandExamples["list"],
+		RunE:    alo.Execute,
+		PreRunE: alo.Validate,
+	}
+	alo.AddFlags(lCmd)
+	aCmd.AddCommand(lCmd)
+
+	return aCmd
+}
+
+var _ types.Command = (*AssignmentOptions)(nil)
+var _ types.Command = (*AssignmentListOptions)(nil)
+var _ types.RESTCall = (*RoleAssignment)(nil)
+var _ types.RESTCall = (*DeleteRoleAssignment)(nil)
+var _ types.RESTCall = (*ListRoleAssignments)(nil)
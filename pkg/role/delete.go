@@ -0,0 +1,88 @@
+package role
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// DeleteRole deletes the role
+type DeleteRole struct {
+	types.ScopedResource
+	// The identifier of the role
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (dr *DeleteRole) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(dr.Request(), roleURL, dr.Identifier)
+}
+
+// Print implements types.RESTCall
+func (dr *DeleteRole) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Role \"%s\" deleted successfully\n", dr.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the role to delete.")
+	cmd.MarkFlagRequired("name")
+	do.ScopeOptions.AddFlags(cmd, "role")
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	dr := &DeleteRole{
+		ScopedResource: do.Resource(),
+		Identifier:     do.Name,
+	}
+
+	dr.Print(dr.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the project custom role
+%[1]s role delete --name pipeline_runner --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	rCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a custom role.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(rCmd)
+
+	return rCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteRole)(nil)
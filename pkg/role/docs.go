@@ -0,0 +1,6 @@
+package role
+
+// role package has the commands that will be used to manipulate the custom roles with their
+// permissions and the role assignments, that bind a principal like a user, a user group or
+// a service account to a role and a resource group at account, organization or project scope.
+// Refer to https://apidocs.harness.io/tag/Roles and https://apidocs.harness.io/tag/Role-Assignments for API
@@ -0,0 +1,91 @@
+package role
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// GetRole gets the role
+type GetRole struct {
+	types.ScopedResource
+	// The identifier of the role
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (gr *GetRole) Call() (map[string]interface{}, error) {
+	return utils.GetResourceByID(gr.Request(), roleURL, gr.Identifier)
+}
+
+// Print implements types.RESTCall
+func (gr *GetRole) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if rr, ok := rm["data"].(map[string]interface{}); ok {
+		en := json.NewEncoder(os.Stdout)
+		en.Encode(roleSummary(rr))
+	}
+}
+
+// AddFlags implements types.Command
+func (gro *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gro.Name, "name", "n", "", "The identifier of the role.")
+	cmd.MarkFlagRequired("name")
+	gro.ScopeOptions.AddFlags(cmd, "role")
+}
+
+// Execute implements types.Command
+func (gro *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gr := &GetRole{
+		ScopedResource: gro.Resource(),
+		Identifier:     gro.Name,
+	}
+
+	gr.Print(gr.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gro *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return gro.ScopeOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the project role with its permissions
+%[1]s role get --name pipeline_runner --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gro := &GetOptions{}
+
+	rCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets a role with its permissions.",
+		Example: getCommandExample,
+		RunE:    gro.Execute,
+		PreRunE: gro.Validate,
+	}
+
+	gro.AddFlags(rCmd)
+
+	return rCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetRole)(nil)
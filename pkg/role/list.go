@@ -0,0 +1,115 @@
+package role
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+	// "all", "builtin" or "custom"
+	Type string
+}
+
+// ListRoles lists the built-in and the custom roles of the scope
+type ListRoles struct {
+	types.ScopedResource
+	// "all", "builtin" or "custom"
+	Type string
+}
+
+// Call implements types.RESTCall
+func (lr *ListRoles) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lr.Request()
+		req.SetQueryParam("pageIndex", fmt.Sprint(index))
+		req.SetQueryParam("pageSize", "100")
+		return utils.GetJSON(req, rolesURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lr *ListRoles) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data, _ := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			rr := c.(map[string]interface{})
+			builtIn, _ := rr["harnessManaged"].(bool)
+			if (lr.Type == "builtin" && !builtIn) || (lr.Type == "custom" && builtIn) {
+				continue
+			}
+			resMap = append(resMap, roleSummary(rr))
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Type, "type", "t", "all", `The type of the roles to list. Valid value is one of "all", "builtin", "custom"`)
+	lo.ScopeOptions.AddFlags(cmd, "role")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lr := &ListRoles{
+		ScopedResource: lo.Resource(),
+		Type:           lo.Type,
+	}
+
+	lr.Print(lr.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if lo.Type != "all" && lo.Type != "builtin" && lo.Type != "custom" {
+		return fmt.Errorf(`"type" should be one of "all", "builtin" or "custom"`)
+	}
+
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the built-in and the custom roles of the project
+%[1]s role list --account-id <your account id> --project-id <project id>
+# List the custom roles of the account
+%[1]s role list --type custom --account-id <your account id> --role-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	rCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the built-in and the custom roles.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(rCmd)
+
+	return rCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListRoles)(nil)
@@ -0,0 +1,167 @@
+package role
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	Name        string
+	Description string
+	// The permissions of the role e.g. "core_pipeline_view"
+	Permissions []string
+	// The file of the permissions, one permission per line
+	File string
+	// The scope levels where the role can be assigned
+	ScopeLevels []string
+}
+
+// Role creates the custom role
+type Role struct {
+	types.ScopedResource
+	Identifier         string   `json:"identifier"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description,omitempty"`
+	Permissions        []string `json:"permissions"`
+	AllowedScopeLevels []string `json:"allowedScopeLevels"`
+}
+
+// Call implements types.RESTCall
+func (r *Role) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(r.Request(), rolesURL, r)
+}
+
+// Print implements types.RESTCall
+func (r *Role) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Role \"%s\" created successfully with %d permissions\n", r.Identifier, len(r.Permissions))
+}
+
+// readPermissions reads the permissions file, one permission per line,
+// the empty lines and the lines starting with "#" are skipped
+func readPermissions(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	permissions := []string{}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		p := strings.TrimSpace(s.Text())
+		if p == "" || strings.HasPrefix(p, "#") {
+			continue
+		}
+		permissions = append(permissions, p)
+	}
+
+	return permissions, s.Err()
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the role.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&co.Description, "description", "d", "", "The description of the role.")
+	cmd.Flags().StringSliceVarP(&co.Permissions, "permission", "", []string{}, `The permissions of the role e.g. "core_pipeline_view,core_pipeline_execute".`)
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", `The file of the permissions of the role, one permission per line.`)
+	cmd.Flags().StringSliceVarP(&co.ScopeLevels, "scope-levels", "", []string{}, `The scope levels where the role can be assigned. Valid values are "account", "org", "project", defaults to the role scope`)
+	co.ScopeOptions.AddFlags(cmd, "role")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	// the errors from here on are not usage errors
+	cmd.SilenceUsage = true
+
+	permissions := co.Permissions
+	if co.File != "" {
+		ps, err := readPermissions(co.File)
+		if err != nil {
+			return err
+		}
+		permissions = append(permissions, ps...)
+	}
+
+	levels := co.ScopeLevels
+	if len(levels) == 0 {
+		levels = []string{co.Scope}
+	}
+	scopeLevels := make([]string, 0, len(levels))
+	for _, l := range levels {
+		scopeLevels = append(scopeLevels, scopeLevel(l))
+	}
+
+	r := &Role{
+		ScopedResource:     co.Resource(),
+		Identifier:         utils.IDFromName(co.Name),
+		Name:               co.Name,
+		Description:        co.Description,
+		Permissions:        permissions,
+		AllowedScopeLevels: scopeLevels,
+	}
+
+	rm, err := r.Call()
+	r.Print(rm, err)
+	if err != nil || rm["status"] != "SUCCESS" {
+		return fmt.Errorf(`unable to create the role "%s"`, co.Name)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if len(co.Permissions) == 0 && co.File == "" {
+		return fmt.Errorf(`one of "permission" or "file" is required`)
+	}
+
+	for _, l := range co.ScopeLevels {
+		if l != "account" && l != "org" && l != "project" {
+			return fmt.Errorf(`"scope-levels" should be one of "account", "org" or "project"`)
+		}
+	}
+
+	return co.ScopeOptions.Validate()
+}
+
+var createCommandExample = fmt.Sprintf(`
+# Create the project role to view and run the pipelines
+%[1]s role new --name pipeline_runner --permission core_pipeline_view,core_pipeline_execute --account-id <your account id> --project-id <project id>
+# Create the account role from the permissions file, assignable at all the scopes
+%[1]s role new --name auditor --file auditor-permissions.txt --scope-levels account,org,project --account-id <your account id> --role-scope account
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	rCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new custom role from its permissions.",
+		Example: createCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(rCmd)
+
+	return rCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*Role)(nil)
@@ -0,0 +1,32 @@
+package role
+
+const (
+	rolesURL                 = "https://app.harness.io/gateway/authz/api/roles"
+	roleURL                  = "https://app.harness.io/gateway/authz/api/roles/{id}"
+	roleAssignmentsURL       = "https://app.harness.io/gateway/authz/api/roleassignments"
+	roleAssignmentURL        = "https://app.harness.io/gateway/authz/api/roleassignments/{id}"
+	roleAssignmentsFilterURL = "https://app.harness.io/gateway/authz/api/roleassignments/filter"
+)
+
+// scopeLevel returns the scope level of the scope as used by the access control API
+func scopeLevel(scope string) string {
+	if scope == "org" {
+		return "organization"
+	}
+	return scope
+}
+
+// roleSummary returns the summary of the role response used by the get and list commands
+func roleSummary(rr map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{
+		"builtIn": rr["harnessManaged"],
+	}
+	if r, ok := rr["role"].(map[string]interface{}); ok {
+		res["name"] = r["name"]
+		res["id"] = r["identifier"]
+		res["description"] = r["description"]
+		res["permissions"] = r["permissions"]
+		res["scopeLevels"] = r["allowedScopeLevels"]
+	}
+	return res
+}
@@ -78,24 +78,13 @@ func (s *Secret) Call() (map[string]interface{}, error) {
 		return nil, err
 	}
 
-	resp, err := req.
-		SetFiles(map[string]string{
+	return utils.PostMultipart(req, "https://app.harness.io/gateway/ng/api/v2/secrets/files",
+		map[string]string{
 			"file": s.File,
-		}).
-		SetFormData(map[string]string{
+		},
+		map[string]string{
 			"spec": string(b),
-		}).
-		Post("https://app.harness.io/gateway/ng/api/v2/secrets/files")
-
-	log.Tracef("URL %s", resp.Request.URL)
-	log.Tracef("BODY %s", resp.Request.Body)
-
-	if err != nil {
-		return nil, err
-	}
-
-	resMap := resp.Result().(*map[string]interface{})
-	return *resMap, err
+		})
 }
 
 // Print implements Command
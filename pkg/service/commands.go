@@ -0,0 +1,40 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewServiceCommands is parent for all the "service" resource commands
+func NewServiceCommands() *cobra.Command {
+	sCmd := &cobra.Command{
+		Use:              "service",
+		Aliases:          []string{"svc"},
+		Short:            "Group of commands to manipulate the services.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	sCmd.AddCommand(newCreateCommand())
+	sCmd.AddCommand(newGetCommand())
+	sCmd.AddCommand(newListCommand())
+	sCmd.AddCommand(newUpdateCommand())
+	sCmd.AddCommand(newDeleteCommand())
+
+	return sCmd
+}
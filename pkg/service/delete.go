@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// DeleteService deletes the service
+type DeleteService struct {
+	types.ScopedResource
+	// The identifier of the service
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (ds *DeleteService) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(ds.Request(), serviceURL, ds.Identifier)
+}
+
+// Print implements types.RESTCall
+func (ds *DeleteService) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Service \"%s\" deleted successfully\n", ds.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the service to delete.")
+	cmd.MarkFlagRequired("name")
+	do.ScopeOptions.AddFlags(cmd, "service")
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	ds := &DeleteService{
+		ScopedResource: do.Resource(),
+		Identifier:     do.Name,
+	}
+
+	ds.Print(ds.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the service
+%[1]s service delete --name my_service --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	sCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a service.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(sCmd)
+
+	return sCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteService)(nil)
@@ -0,0 +1,5 @@
+package service
+
+// service package has the commands that will be used to manipulate the Harness services
+// at account, organization or project scope from their YAML.
+// Refer to https://apidocs.harness.io/tag/Services for API
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// GetService gets the service YAML
+type GetService struct {
+	types.ScopedResource
+	// The identifier of the service
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (gs *GetService) Call() (map[string]interface{}, error) {
+	return utils.GetResourceByID(gs.Request(), serviceURL, gs.Identifier)
+}
+
+// Print implements types.RESTCall
+func (gs *GetService) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	data := rm["data"].(map[string]interface{})
+	if s, ok := data["service"].(map[string]interface{}); ok {
+		fmt.Print(s["yaml"])
+	}
+}
+
+// AddFlags implements types.Command
+func (gso *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gso.Name, "name", "n", "", "The identifier of the service.")
+	cmd.MarkFlagRequired("name")
+	gso.ScopeOptions.AddFlags(cmd, "service")
+}
+
+// Execute implements types.Command
+func (gso *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gs := &GetService{
+		ScopedResource: gso.Resource(),
+		Identifier:     gso.Name,
+	}
+
+	gs.Print(gs.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gso *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return gso.ScopeOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the service YAML
+%[1]s service get --name my_service --account-id <your account id> --project-id <project id>
+# Get the YAML of an organization service
+%[1]s service get --name my_service --account-id <your account id> --service-scope org
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gso := &GetOptions{}
+
+	sCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets the service YAML.",
+		Example: getCommandExample,
+		RunE:    gso.Execute,
+		PreRunE: gso.Validate,
+	}
+
+	gso.AddFlags(sCmd)
+
+	return sCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetService)(nil)
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+}
+
+// ListServices lists the services of the scope
+type ListServices struct {
+	types.ScopedResource
+}
+
+// Call implements types.RESTCall
+func (ls *ListServices) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := ls.Request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		return utils.GetJSON(req, servicesURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (ls *ListServices) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			s, ok := c.(map[string]interface{})["service"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			resMap = append(resMap, map[string]interface{}{
+				"name":        s["name"],
+				"id":          s["identifier"],
+				"description": s["description"],
+				"tags":        s["tags"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	lo.ScopeOptions.AddFlags(cmd, "service")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	ls := &ListServices{
+		ScopedResource: lo.Resource(),
+	}
+
+	ls.Print(ls.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the services of the project
+%[1]s service list --account-id <your account id> --project-id <project id>
+# List the account services
+%[1]s service list --account-id <your account id> --service-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	sCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the services.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(sCmd)
+
+	return sCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListServices)(nil)
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	// The service YAML file
+	File string
+}
+
+// Call implements types.RESTCall
+func (s *Service) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(s.Request(), servicesURL, s)
+}
+
+// Print implements types.RESTCall
+func (s *Service) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Service \"%s\" created successfully\n", s.Identifier)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", "The service YAML file.")
+	cmd.MarkFlagRequired("file")
+	co.ScopeOptions.AddFlags(cmd, "service")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	s, err := readService(co.Resource(), co.File)
+	if err != nil {
+		return err
+	}
+
+	s.Print(s.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return co.ScopeOptions.Validate()
+}
+
+var createCommandExample = fmt.Sprintf(`
+# Create the service from its YAML
+%[1]s service new --file service.yaml --account-id <your account id> --project-id <project id>
+# Create the service at the account scope
+%[1]s service new --file service.yaml --account-id <your account id> --service-scope account
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	sCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new service from its YAML.",
+		Example: createCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(sCmd)
+
+	return sCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*Service)(nil)
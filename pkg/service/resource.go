@@ -0,0 +1,43 @@
+package service
+
+import (
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+)
+
+const (
+	servicesURL = "https://app.harness.io/gateway/ng/api/servicesV2"
+	serviceURL  = "https://app.harness.io/gateway/ng/api/servicesV2/{id}"
+)
+
+// Service holds the service definition that will be created or updated from its YAML
+type Service struct {
+	types.ScopedResource
+	Identifier        string            `json:"identifier"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description,omitempty"`
+	OrgIdentifier     string            `json:"orgIdentifier,omitempty"`
+	ProjectIdentifier string            `json:"projectIdentifier,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
+	// The service YAML
+	YAML string `json:"yaml"`
+}
+
+// readService reads the service YAML file and builds the Service at the scope of the resource
+func readService(r types.ScopedResource, file string) (*Service, error) {
+	b, s, err := utils.ReadYAMLResource(file, "service")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		ScopedResource:    r,
+		Identifier:        utils.StringValue(s, "identifier"),
+		Name:              utils.StringValue(s, "name"),
+		Description:       utils.StringValue(s, "description"),
+		OrgIdentifier:     r.OrgID,
+		ProjectIdentifier: r.ProjectID,
+		Tags:              utils.TagsValue(s),
+		YAML:              string(b),
+	}, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type UpdateOptions struct {
+	types.ScopeOptions
+	// The service YAML file
+	File string
+}
+
+// UpdateService updates the service with its YAML
+type UpdateService struct {
+	*Service
+}
+
+// Call implements types.RESTCall
+func (us *UpdateService) Call() (map[string]interface{}, error) {
+	return utils.PutJSON(us.Request(), servicesURL, us.Service)
+}
+
+// Print implements types.RESTCall
+func (us *UpdateService) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Service \"%s\" updated successfully\n", us.Identifier)
+}
+
+// AddFlags implements types.Command
+func (uo *UpdateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&uo.File, "file", "f", "", "The service YAML file, the service is identified by its identifier.")
+	cmd.MarkFlagRequired("file")
+	uo.ScopeOptions.AddFlags(cmd, "service")
+}
+
+// Execute implements types.Command
+func (uo *UpdateOptions) Execute(cmd *cobra.Command, args []string) error {
+	s, err := readService(uo.Resource(), uo.File)
+	if err != nil {
+		return err
+	}
+
+	us := &UpdateService{s}
+	us.Print(us.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (uo *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return uo.ScopeOptions.Validate()
+}
+
+var updateCommandExample = fmt.Sprintf(`
+# Update the service from its YAML
+%[1]s service update --file service.yaml --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newUpdateCommand instantiates the new instance of the newUpdateCommand
+func newUpdateCommand() *cobra.Command {
+	uo := &UpdateOptions{}
+
+	sCmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Updates the service from its YAML.",
+		Example: updateCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(sCmd)
+
+	return sCmd
+}
+
+var _ types.Command = (*UpdateOptions)(nil)
+var _ types.RESTCall = (*UpdateService)(nil)
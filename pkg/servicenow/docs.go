@@ -0,0 +1,3 @@
+package servicenow
+
+// servicenow package defines the commands to manipulate ServiceNow connector resource, used by the ServiceNow approval and ServiceNow create/update steps
@@ -0,0 +1,242 @@
+package servicenow
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/delegate"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	connectorType        = "ServiceNow"
+	passwordAuthType     = "UsernamePassword"
+	adfsAuthType         = "AdfsClientCredentialsWithCertificate"
+	refreshTokenAuthType = "RefreshTokenGrantType"
+)
+
+type CreateOptions struct {
+	// password, adfs or refresh-token
+	AuthenticationType string
+	Name               string
+	ProjectID          string
+	Scope              string
+	URL                string
+	DelegateSelectors  []string
+	CheckDelegateTags  bool
+	// used with "password" authentication
+	UserName string
+	Password string
+	// used with "adfs" authentication
+	ADFSURL     string
+	Certificate string
+	PrivateKey  string
+	ResourceID  string
+	// used with "adfs" and "refresh-token" authentication
+	ClientID string
+	// used with "refresh-token" authentication
+	ClientSecret string
+	RefreshToken string
+	TokenURL     string
+	TokenScope   string
+}
+
+type UserNamePasswordAuth struct {
+	UserName string `json:"username"`
+	Password string `json:"passwordRef"`
+}
+
+type ADFSAuth struct {
+	ADFSURL        string `json:"adfsUrl"`
+	CertificateRef string `json:"certificateRef"`
+	PrivateKeyRef  string `json:"privateKeyRef"`
+	ClientIDRef    string `json:"clientIdRef"`
+	ResourceIDRef  string `json:"resourceIdRef"`
+}
+
+type RefreshTokenAuth struct {
+	TokenURL        string `json:"tokenUrl"`
+	RefreshTokenRef string `json:"refreshTokenRef"`
+	ClientIDRef     string `json:"clientIdRef"`
+	ClientSecretRef string `json:"clientSecretRef,omitempty"`
+	Scope           string `json:"scope,omitempty"`
+}
+
+type Authentication struct {
+	Type string      `json:"type"`
+	Spec interface{} `json:"spec"`
+}
+
+type Spec struct {
+	Authentication    Authentication `json:"auth"`
+	URL               string         `json:"serviceNowUrl"`
+	DelegateSelectors []string       `json:"delegateSelectors,omitempty"`
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the connector.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&co.URL, "url", "", "", "The ServiceNow URL e.g. https://mycompany.service-now.com")
+	cmd.MarkFlagRequired("url")
+	cmd.Flags().StringVarP(&co.AuthenticationType, "auth-type", "", "password", "The authentication type valid values are 'password', 'adfs' or 'refresh-token' ")
+	cmd.Flags().StringVarP(&co.UserName, "username", "u", "", `The ServiceNow user name. Required when "auth-type" is "password"`)
+	cmd.Flags().StringVarP(&co.Password, "password", "", "", `The ID of the ServiceNow password secret. Required when "auth-type" is "password". Use scope for finer access e.g. account.snowpassword, org.snowpassword etc.,`)
+	cmd.Flags().StringVarP(&co.ADFSURL, "adfs-url", "", "", `The ADFS URL. Required when "auth-type" is "adfs"`)
+	cmd.Flags().StringVarP(&co.Certificate, "certificate", "", "", `The ID of the ADFS certificate secret. Required when "auth-type" is "adfs"`)
+	cmd.Flags().StringVarP(&co.PrivateKey, "private-key", "", "", `The ID of the ADFS private key secret. Required when "auth-type" is "adfs"`)
+	cmd.Flags().StringVarP(&co.ResourceID, "resource-id", "", "", `The ID of the ADFS resource ID secret. Required when "auth-type" is "adfs"`)
+	cmd.Flags().StringVarP(&co.ClientID, "client-id", "", "", `The ID of the client ID secret. Required when "auth-type" is "adfs" or "refresh-token"`)
+	cmd.Flags().StringVarP(&co.ClientSecret, "client-secret", "", "", `The ID of the client secret secret. Used only when "auth-type" is "refresh-token"`)
+	cmd.Flags().StringVarP(&co.RefreshToken, "refresh-token", "", "", `The ID of the refresh token secret. Required when "auth-type" is "refresh-token"`)
+	cmd.Flags().StringVarP(&co.TokenURL, "token-url", "", "", `The OAuth token URL. Required when "auth-type" is "refresh-token"`)
+	cmd.Flags().StringVarP(&co.TokenScope, "token-scope", "", "", `The OAuth scope to request. Used only when "auth-type" is "refresh-token"`)
+	cmd.Flags().StringVarP(&co.ProjectID, "project-id", "p", "", `The project where the connector will be created.`)
+	cmd.Flags().StringVarP(&co.Scope, "connector-scope", "", "project", `The connector scope. Valid value is one of "project", "org", "account"`)
+	cmd.Flags().StringSliceVarP(&co.DelegateSelectors, "delegate-tags", "", []string{}, `The delegate tags that will be used to select the available delegate that will be used by the connector.`)
+	cmd.Flags().BoolVarP(&co.CheckDelegateTags, "check-delegate-tags", "", false, "Warn when none of the delegate tags match an existing delegate.")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	c := &types.Connector{
+		APIKey:     viper.GetString("api-key"),
+		AccountID:  viper.GetString("account-id"),
+		Name:       co.Name,
+		Identifier: utils.IDFromName(co.Name),
+		Type:       connectorType,
+		Scope:      co.Scope,
+	}
+
+	if co.Scope == "project" {
+		c.OrgID = viper.GetString("org-id")
+		c.ProjectID = co.ProjectID
+	} else if co.Scope == "org" {
+		c.OrgID = viper.GetString("org-id")
+	}
+
+	spec := &Spec{
+		URL: co.URL,
+	}
+
+	switch co.AuthenticationType {
+	case "adfs":
+		spec.Authentication = Authentication{
+			Type: adfsAuthType,
+			Spec: ADFSAuth{
+				ADFSURL:        co.ADFSURL,
+				CertificateRef: scopedName(co.Scope, co.Certificate),
+				PrivateKeyRef:  scopedName(co.Scope, co.PrivateKey),
+				ClientIDRef:    scopedName(co.Scope, co.ClientID),
+				ResourceIDRef:  scopedName(co.Scope, co.ResourceID),
+			},
+		}
+	case "refresh-token":
+		rt := RefreshTokenAuth{
+			TokenURL:        co.TokenURL,
+			RefreshTokenRef: scopedName(co.Scope, co.RefreshToken),
+			ClientIDRef:     scopedName(co.Scope, co.ClientID),
+			Scope:           co.TokenScope,
+		}
+		if co.ClientSecret != "" {
+			rt.ClientSecretRef = scopedName(co.Scope, co.ClientSecret)
+		}
+		spec.Authentication = Authentication{
+			Type: refreshTokenAuthType,
+			Spec: rt,
+		}
+	default:
+		spec.Authentication = Authentication{
+			Type: passwordAuthType,
+			Spec: UserNamePasswordAuth{
+				UserName: co.UserName,
+				Password: scopedName(co.Scope, co.Password),
+			},
+		}
+	}
+
+	if len(co.DelegateSelectors) > 0 {
+		spec.DelegateSelectors = co.DelegateSelectors
+	}
+
+	c.Spec = spec
+
+	if co.CheckDelegateTags {
+		delegate.WarnOnUnmatchedSelectors(c.APIKey, c.AccountID, c.Scope, c.OrgID, c.ProjectID, co.DelegateSelectors)
+	}
+
+	ci := &types.ConnectorInfo{
+		ConnectorInfo: *c,
+	}
+
+	ci.Call()
+
+	return nil
+}
+
+func scopedName(scope, name string) string {
+	if scope == "account" {
+		return fmt.Sprintf("account.%s", name)
+	}
+
+	if scope == "org" {
+		return fmt.Sprintf("org.%s", name)
+	}
+
+	return name
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	switch co.AuthenticationType {
+	case "password":
+		if co.UserName == "" || co.Password == "" {
+			return fmt.Errorf(`"username" and "password" is required for password authentication`)
+		}
+	case "adfs":
+		if co.ADFSURL == "" || co.Certificate == "" || co.PrivateKey == "" || co.ClientID == "" || co.ResourceID == "" {
+			return fmt.Errorf(`"adfs-url", "certificate", "private-key", "client-id" and "resource-id" is required for adfs authentication`)
+		}
+	case "refresh-token":
+		if co.TokenURL == "" || co.RefreshToken == "" || co.ClientID == "" {
+			return fmt.Errorf(`"token-url", "refresh-token" and "client-id" is required for refresh-token authentication`)
+		}
+	default:
+		return fmt.Errorf(`"auth-type" should be one of "password", "adfs" or "refresh-token"`)
+	}
+
+	return nil
+}
+
+var newCommandExample = fmt.Sprintf(`
+# Create new ServiceNow connector with username and password
+%[1]s servicenow new --name snow --account-id <your account id> --project-id <project id> --url https://mycompany.service-now.com --username foo --password snow-password
+# Create new ServiceNow connector with ADFS client credentials
+%[1]s servicenow new --name snow --account-id <your account id> --project-id <project id> --url https://mycompany.service-now.com --auth-type adfs --adfs-url https://adfs.example.com --certificate snow-cert --private-key snow-key --client-id snow-client-id --resource-id snow-resource-id
+# Create new ServiceNow connector with OAuth refresh token
+%[1]s servicenow new --name snow --account-id <your account id> --project-id <project id> --url https://mycompany.service-now.com --auth-type refresh-token --token-url https://mycompany.service-now.com/oauth_token.do --refresh-token snow-refresh-token --client-id snow-client-id --client-secret snow-client-secret
+`, common.ExamplePrefix())
+
+// newConnectorCommand instantiates the new instance of the newConnectorCommand
+func newConnectorCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	sCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new ServiceNow connector if not exists.",
+		Example: newCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(sCmd)
+
+	return sCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
@@ -0,0 +1,39 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package template
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewTemplateCommands is parent for all the "template" resource commands
+func NewTemplateCommands() *cobra.Command {
+	tCmd := &cobra.Command{
+		Use:              "template",
+		Aliases:          []string{"tpl"},
+		Short:            "Group of commands to manipulate the step, stage and pipeline templates and their versions.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	tCmd.AddCommand(newCreateCommand())
+	tCmd.AddCommand(newGetCommand())
+	tCmd.AddCommand(newListCommand())
+	tCmd.AddCommand(newVersionCommands()...)
+
+	return tCmd
+}
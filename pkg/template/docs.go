@@ -0,0 +1,6 @@
+package template
+
+// template package has the commands that will be used to manipulate the Harness templates
+// at account, organization or project scope. Each template has one or more versions created
+// from their YAML, one of them being the stable version used by default.
+// Refer to https://apidocs.harness.io/tag/Templates for API
@@ -0,0 +1,99 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	Name string
+	// The version label, the stable version when empty
+	Version string
+}
+
+// GetTemplate gets the YAML of a template version
+type GetTemplate struct {
+	types.ScopedResource
+	// The identifier of the template
+	Identifier string
+	// The version label, the stable version when empty
+	Version string
+}
+
+// Call implements types.RESTCall
+func (gt *GetTemplate) Call() (map[string]interface{}, error) {
+	req := gt.Request()
+	if gt.Version != "" {
+		req.SetQueryParam("versionLabel", gt.Version)
+	}
+	return utils.GetResourceByID(req, templateURL, gt.Identifier)
+}
+
+// Print implements types.RESTCall
+func (gt *GetTemplate) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	data := rm["data"].(map[string]interface{})
+	fmt.Print(data["yaml"])
+}
+
+// AddFlags implements types.Command
+func (gto *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gto.Name, "name", "n", "", "The identifier of the template.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&gto.Version, "version", "", "", "The version label of the template, defaults to the stable version.")
+	gto.ScopeOptions.AddFlags(cmd, "template")
+}
+
+// Execute implements types.Command
+func (gto *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gt := &GetTemplate{
+		ScopedResource: gto.Resource(),
+		Identifier:     gto.Name,
+		Version:        gto.Version,
+	}
+
+	gt.Print(gt.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gto *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return gto.ScopeOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the YAML of the stable version of the account template
+%[1]s template get --name build_step --account-id <your account id> --template-scope account
+# Get the YAML of a version of the template
+%[1]s template get --name build_step --version v2 --account-id <your account id> --template-scope account
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gto := &GetOptions{}
+
+	tCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets the YAML of a template version.",
+		Example: getCommandExample,
+		RunE:    gto.Execute,
+		PreRunE: gto.Validate,
+	}
+
+	gto.AddFlags(tCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetTemplate)(nil)
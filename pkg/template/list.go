@@ -0,0 +1,133 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+	// The identifier of the template to list its versions
+	Name string
+	// The template type that will be used to filter the templates e.g. "Step", "Stage"
+	Type string
+}
+
+// ListTemplates lists the templates of the scope or the versions of a template
+type ListTemplates struct {
+	types.ScopedResource
+	// "All" to list the versions of a template, "Stable" to list the templates
+	ListType string `json:"-"`
+	// Always "Template"
+	FilterType string `json:"filterType"`
+	// The identifier of the template to list its versions
+	TemplateIdentifiers []string `json:"templateIdentifiers,omitempty"`
+	// The template type that will be used to filter the templates
+	TemplateEntityTypes []string `json:"templateEntityTypes,omitempty"`
+}
+
+// Call implements types.RESTCall
+func (lt *ListTemplates) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lt.Request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		req.SetQueryParam("templateListType", lt.ListType)
+		return utils.PostJSON(req, templateListURL, lt)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lt *ListTemplates) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			t := c.(map[string]interface{})
+			resMap = append(resMap, map[string]interface{}{
+				"name":    t["name"],
+				"id":      t["identifier"],
+				"version": t["versionLabel"],
+				"type":    t["templateEntityType"],
+				"stable":  t["stableTemplate"],
+				"tags":    t["tags"],
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Name, "name", "n", "", "The identifier of the template to list its versions, lists the stable version of all the templates when empty.")
+	cmd.Flags().StringVarP(&lo.Type, "type", "t", "", `The template type to filter the templates e.g. "Step", "Stage", "Pipeline"`)
+	lo.ScopeOptions.AddFlags(cmd, "template")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lt := &ListTemplates{
+		ScopedResource: lo.Resource(),
+		ListType:       "Stable",
+		FilterType:     "Template",
+	}
+
+	if lo.Name != "" {
+		lt.ListType = "All"
+		lt.TemplateIdentifiers = []string{lo.Name}
+	}
+
+	if lo.Type != "" {
+		lt.TemplateEntityTypes = []string{lo.Type}
+	}
+
+	lt.Print(lt.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the account step templates
+%[1]s template list --account-id <your account id> --template-scope account --type Step
+# List the versions of the account template
+%[1]s template list --name build_step --account-id <your account id> --template-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	tCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the templates or the versions of a template.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(tCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListTemplates)(nil)
@@ -0,0 +1,119 @@
+package template
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	// The template YAML file
+	File string
+	// Whether to mark the new version as the stable version
+	Stable bool
+	// The comments of the new version
+	Comments string
+}
+
+// Template creates a new version of the template from its YAML
+type Template struct {
+	types.ScopedResource
+	// The identifier of the template
+	Identifier string
+	// The version label of the template
+	Version string
+	// Whether to mark the new version as the stable version
+	Stable bool
+	// The comments of the new version
+	Comments string
+	// The template YAML
+	YAML []byte
+}
+
+// Call implements types.RESTCall
+func (t *Template) Call() (map[string]interface{}, error) {
+	req := t.Request()
+	req.SetQueryParam("storeType", "INLINE")
+	req.SetQueryParam("setDefaultTemplate", strconv.FormatBool(t.Stable))
+	if t.Comments != "" {
+		req.SetQueryParam("comments", t.Comments)
+	}
+	return utils.PostYAML(req, templatesURL, t.YAML)
+}
+
+// Print implements types.RESTCall
+func (t *Template) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Template \"%s\" version \"%s\" created successfully\n", t.Identifier, t.Version)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", `The template YAML file, the version is identified by its "versionLabel".`)
+	cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVarP(&co.Stable, "stable", "", false, "Mark the new version as the stable version of the template.")
+	cmd.Flags().StringVarP(&co.Comments, "comments", "c", "", "The comments of the new version.")
+	co.ScopeOptions.AddFlags(cmd, "template")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	b, id, version, err := readTemplate(co.File)
+	if err != nil {
+		return err
+	}
+
+	t := &Template{
+		ScopedResource: co.Resource(),
+		Identifier:     id,
+		Version:        version,
+		Stable:         co.Stable,
+		Comments:       co.Comments,
+		YAML:           b,
+	}
+
+	t.Print(t.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return co.ScopeOptions.Validate()
+}
+
+var createCommandExample = fmt.Sprintf(`
+# Create a new version of the account template from its YAML
+%[1]s template new --file build-step.yaml --account-id <your account id> --template-scope account
+# Create a new version of the project template and mark it as stable
+%[1]s template new --file build-step.yaml --account-id <your account id> --project-id <project id> --stable --comments "Use the new cache"
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	tCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new template version from its YAML.",
+		Example: createCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(tCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*Template)(nil)
@@ -0,0 +1,31 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+)
+
+const (
+	templatesURL       = "https://app.harness.io/gateway/template/api/templates"
+	templateURL        = "https://app.harness.io/gateway/template/api/templates/{id}"
+	templateListURL    = "https://app.harness.io/gateway/template/api/templates/list"
+	templateVersionURL = "https://app.harness.io/gateway/template/api/templates/{id}/{version}"
+	stableTemplateURL  = "https://app.harness.io/gateway/template/api/templates/updateStableTemplate/{id}/{version}"
+)
+
+// readTemplate reads the template YAML file and returns its content with
+// the identifier and the version label of the template
+func readTemplate(file string) ([]byte, string, string, error) {
+	b, t, err := utils.ReadYAMLResource(file, "template")
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	version := utils.StringValue(t, "versionLabel")
+	if version == "" {
+		return nil, "", "", fmt.Errorf(`"%s" has no "template.versionLabel"`, file)
+	}
+
+	return b, utils.StringValue(t, "identifier"), version, nil
+}
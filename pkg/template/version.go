@@ -0,0 +1,131 @@
+package template
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type VersionOptions struct {
+	types.ScopeOptions
+	Name    string
+	Version string
+	// The comments of the change
+	Comments string
+	// "stable" or "delete"
+	action string
+}
+
+// TemplateVersion marks the template version as stable or deletes it
+type TemplateVersion struct {
+	types.ScopedResource
+	// The identifier of the template
+	Identifier string
+	// The version label of the template
+	Version string
+	// The comments of the change
+	Comments string
+	// whether to delete the version instead of marking it as stable
+	delete bool
+}
+
+// Call implements types.RESTCall
+func (tv *TemplateVersion) Call() (map[string]interface{}, error) {
+	req := tv.Request()
+	req.SetPathParams(map[string]string{
+		"id":      tv.Identifier,
+		"version": tv.Version,
+	})
+	if tv.Comments != "" {
+		req.SetQueryParam("comments", tv.Comments)
+	}
+	if tv.delete {
+		return utils.DeleteJSON(req, templateVersionURL)
+	}
+	return utils.PutJSON(req, stableTemplateURL, nil)
+}
+
+// Print implements types.RESTCall
+func (tv *TemplateVersion) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	if tv.delete {
+		fmt.Printf("Template \"%s\" version \"%s\" deleted successfully\n", tv.Identifier, tv.Version)
+		return
+	}
+	fmt.Printf("Template \"%s\" version \"%s\" marked as stable successfully\n", tv.Identifier, tv.Version)
+}
+
+// AddFlags implements types.Command
+func (vo *VersionOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&vo.Name, "name", "n", "", "The identifier of the template.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&vo.Version, "version", "", "", "The version label of the template.")
+	cmd.MarkFlagRequired("version")
+	cmd.Flags().StringVarP(&vo.Comments, "comments", "c", "", "The comments of the change.")
+	vo.ScopeOptions.AddFlags(cmd, "template")
+}
+
+// Execute implements types.Command
+func (vo *VersionOptions) Execute(cmd *cobra.Command, args []string) error {
+	tv := &TemplateVersion{
+		ScopedResource: vo.Resource(),
+		Identifier:     vo.Name,
+		Version:        vo.Version,
+		Comments:       vo.Comments,
+		delete:         vo.action == "delete",
+	}
+
+	tv.Print(tv.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (vo *VersionOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return vo.ScopeOptions.Validate()
+}
+
+var versionCommandExamples = map[string]string{
+	"stable": fmt.Sprintf(`
+# Mark the version of the account template as stable
+%[1]s template stable --name build_step --version v2 --account-id <your account id> --template-scope account
+`, common.ExamplePrefix()),
+	"delete": fmt.Sprintf(`
+# Delete the version of the account template
+%[1]s template delete --name build_step --version v1 --account-id <your account id> --template-scope account
+`, common.ExamplePrefix()),
+}
+
+var versionCommandShorts = map[string]string{
+	"stable": "Marks a template version as the stable version.",
+	"delete": "Deletes a template version.",
+}
+
+// newVersionCommands instantiates the new instances of the template stable and delete commands
+func newVersionCommands() []*cobra.Command {
+	cmds := []*cobra.Command{}
+	for _, action := range []string{"stable", "delete"} {
+		vo := &VersionOptions{action: action}
+		vCmd := &cobra.Command{
+			Use:     action,
+			Short:   versionCommandShorts[action],
+			Example: versionCommandExamples[action],
+			RunE:    vo.Execute,
+			PreRunE: vo.Validate,
+		}
+		vo.AddFlags(vCmd)
+		cmds = append(cmds, vCmd)
+	}
+	return cmds
+}
+
+var _ types.Command = (*VersionOptions)(nil)
+var _ types.RESTCall = (*TemplateVersion)(nil)
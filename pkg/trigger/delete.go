@@ -0,0 +1,88 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	PipelineOptions
+	Name string
+}
+
+// DeleteTrigger deletes the trigger
+type DeleteTrigger struct {
+	Target
+	// The identifier of the trigger
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (dt *DeleteTrigger) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(dt.request(), triggerURL, dt.Identifier)
+}
+
+// Print implements types.RESTCall
+func (dt *DeleteTrigger) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Trigger \"%s\" deleted successfully\n", dt.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the trigger to delete.")
+	cmd.MarkFlagRequired("name")
+	do.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	dt := &DeleteTrigger{
+		Target:     do.target(),
+		Identifier: do.Name,
+	}
+
+	dt.Print(dt.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the trigger of the pipeline
+%[1]s trigger delete --name nightly --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	tCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a trigger of the pipeline.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(tCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteTrigger)(nil)
@@ -0,0 +1,4 @@
+package trigger
+
+// trigger package has the commands that will be used to manipulate the webhook, cron, artifact and manifest triggers of the Harness Pipelines.
+// Refer to https://apidocs.harness.io/tag/Triggers for API
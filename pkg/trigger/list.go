@@ -0,0 +1,113 @@
+package trigger
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	PipelineOptions
+}
+
+// ListTriggers lists the triggers of the pipeline
+type ListTriggers struct {
+	Target
+}
+
+// Call implements types.RESTCall
+func (lt *ListTriggers) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lt.request()
+		req.SetQueryParam("page", fmt.Sprint(index))
+		req.SetQueryParam("size", "100")
+		return utils.GetJSON(req, triggersURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lt *ListTriggers) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	content, _ := data["content"].([]interface{})
+	for _, c := range content {
+		t := c.(map[string]interface{})
+		id, _ := t["identifier"].(string)
+		triggerType, _ := t["type"].(string)
+		res := map[string]interface{}{
+			"name":    t["name"],
+			"id":      id,
+			"type":    triggerType,
+			"enabled": t["enabled"],
+			"tags":    t["tags"],
+		}
+		webhookType := ""
+		if wd, ok := t["webhookDetails"].(map[string]interface{}); ok {
+			webhookType, _ = wd["webhookSourceRepo"].(string)
+		}
+		if url := lt.webhookURL(id, triggerType, webhookType); url != "" {
+			res["webhookUrl"] = url
+		}
+		resMap = append(resMap, res)
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	lo.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lt := &ListTriggers{
+		Target: lo.target(),
+	}
+
+	lt.Print(lt.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the triggers of the pipeline with their webhook URLs
+%[1]s trigger list --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	tCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the triggers of the pipeline.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(tCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListTriggers)(nil)
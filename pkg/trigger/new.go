@@ -0,0 +1,266 @@
+package trigger
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// scmTypes maps the "scm" flag to the Harness webhook type
+var scmTypes = map[string]string{
+	"github":    "Github",
+	"gitlab":    "Gitlab",
+	"bitbucket": "Bitbucket",
+}
+
+type CreateOptions struct {
+	PipelineOptions
+	// The trigger YAML file, used for any trigger e.g. artifact and manifest triggers
+	File string
+	// The name of the trigger, used with "webhook" and "cron" triggers
+	Name string
+	// "webhook" or "cron"
+	Type string
+	// The identifiers of the input sets to run the pipeline with
+	InputSets []string
+	// "github", "gitlab" or "bitbucket"
+	SCM string
+	// The name of the SCM connector
+	Connector string
+	// The connector scope "account", "org" or "project"
+	ConnectorScope string
+	// The repository name, used with account level connectors
+	Repo string
+	// The webhook event e.g. "PullRequest", "Push"
+	Event string
+	// The webhook event actions e.g. "Open", "Reopen"
+	Actions []string
+	// The cron expression of the "cron" trigger
+	Expression string
+}
+
+// TypedSpec is a typed spec of the trigger YAML
+type TypedSpec struct {
+	Type string      `yaml:"type"`
+	Spec interface{} `yaml:"spec"`
+}
+
+// WebhookSpec is the spec of the webhook event
+type WebhookSpec struct {
+	ConnectorRef                string        `yaml:"connectorRef"`
+	RepoName                    string        `yaml:"repoName,omitempty"`
+	AutoAbortPreviousExecutions bool          `yaml:"autoAbortPreviousExecutions"`
+	PayloadConditions           []interface{} `yaml:"payloadConditions"`
+	HeaderConditions            []interface{} `yaml:"headerConditions"`
+	Actions                     []string      `yaml:"actions"`
+}
+
+// CronSpec is the spec of the cron trigger
+type CronSpec struct {
+	Expression string `yaml:"expression"`
+}
+
+// TriggerSpec is the trigger of the trigger YAML
+type TriggerSpec struct {
+	Name               string    `yaml:"name"`
+	Identifier         string    `yaml:"identifier"`
+	Enabled            bool      `yaml:"enabled"`
+	OrgIdentifier      string    `yaml:"orgIdentifier"`
+	ProjectIdentifier  string    `yaml:"projectIdentifier"`
+	PipelineIdentifier string    `yaml:"pipelineIdentifier"`
+	Source             TypedSpec `yaml:"source"`
+	InputSetRefs       []string  `yaml:"inputSetRefs,omitempty"`
+}
+
+// Trigger creates the trigger from its YAML
+type Trigger struct {
+	Target
+	// The identifier of the trigger
+	Identifier string
+	// The trigger YAML
+	YAML []byte
+}
+
+// Call implements types.RESTCall
+func (t *Trigger) Call() (map[string]interface{}, error) {
+	return utils.PostYAML(t.request(), triggersURL, t.YAML)
+}
+
+// Print implements types.RESTCall
+func (t *Trigger) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Trigger \"%s\" created successfully\n", t.Identifier)
+	t.printWebhookURL(rm)
+}
+
+// triggerYAML builds the trigger YAML of the "webhook" or "cron" trigger
+func (co *CreateOptions) triggerYAML(t Target) ([]byte, error) {
+	ts := TriggerSpec{
+		Name:               co.Name,
+		Identifier:         utils.IDFromName(co.Name),
+		Enabled:            true,
+		OrgIdentifier:      t.OrgID,
+		ProjectIdentifier:  t.ProjectID,
+		PipelineIdentifier: t.PipelineID,
+		InputSetRefs:       co.InputSets,
+	}
+
+	if co.Type == "cron" {
+		ts.Source = TypedSpec{
+			Type: "Scheduled",
+			Spec: TypedSpec{
+				Type: "Cron",
+				Spec: CronSpec{Expression: co.Expression},
+			},
+		}
+	} else {
+		ts.Source = TypedSpec{
+			Type: "Webhook",
+			Spec: TypedSpec{
+				Type: scmTypes[co.SCM],
+				Spec: TypedSpec{
+					Type: co.Event,
+					Spec: WebhookSpec{
+						ConnectorRef:      scopedName(co.ConnectorScope, utils.IDFromName(co.Connector)),
+						RepoName:          co.Repo,
+						PayloadConditions: []interface{}{},
+						HeaderConditions:  []interface{}{},
+						Actions:           co.Actions,
+					},
+				},
+			},
+		}
+	}
+
+	return yaml.Marshal(map[string]interface{}{"trigger": ts})
+}
+
+func scopedName(scope, name string) string {
+	if scope == "account" {
+		return fmt.Sprintf("account.%s", name)
+	}
+
+	if scope == "org" {
+		return fmt.Sprintf("org.%s", name)
+	}
+
+	return name
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.File, "file", "f", "", "The trigger YAML file, used to create any type of trigger e.g. artifact or manifest triggers.")
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", `The name of the trigger. Required when "file" is not set.`)
+	cmd.Flags().StringVarP(&co.Type, "type", "", "webhook", `The type of the trigger when "file" is not set. Valid values are "webhook" or "cron"`)
+	cmd.Flags().StringSliceVarP(&co.InputSets, "input-set", "i", []string{}, "The identifiers of the input sets to run the pipeline with.")
+	cmd.Flags().StringVarP(&co.SCM, "scm", "", "github", `The SCM of the "webhook" trigger. Valid values are "github", "gitlab" or "bitbucket"`)
+	cmd.Flags().StringVarP(&co.Connector, "connector", "c", "", `The name of the SCM connector of the "webhook" trigger e.g. the name used with "github new".`)
+	cmd.Flags().StringVarP(&co.ConnectorScope, "connector-scope", "", "project", `The scope of the SCM connector. Valid value is one of "project", "org", "account"`)
+	cmd.Flags().StringVarP(&co.Repo, "repo", "", "", `The repository name of the "webhook" trigger, required when the connector is an account level connector.`)
+	cmd.Flags().StringVarP(&co.Event, "event", "e", "PullRequest", `The event of the "webhook" trigger e.g. "PullRequest", "Push" for GitHub and Bitbucket, "MergeRequest", "Push" for GitLab.`)
+	cmd.Flags().StringSliceVarP(&co.Actions, "actions", "", []string{}, `The event actions of the "webhook" trigger e.g. "Open", "Reopen", "Synchronize". All the actions when not set.`)
+	cmd.Flags().StringVarP(&co.Expression, "expression", "", "", `The cron expression of the "cron" trigger e.g. "0 2 * * *".`)
+	co.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	t := &Trigger{
+		Target: co.target(),
+	}
+
+	if co.File != "" {
+		b, id, err := pipeline.ReadYAML(co.File, "trigger")
+		if err != nil {
+			return err
+		}
+		t.Identifier = id
+		t.YAML = b
+	} else {
+		b, err := co.triggerYAML(t.Target)
+		if err != nil {
+			return err
+		}
+		t.Identifier = utils.IDFromName(co.Name)
+		t.YAML = b
+	}
+
+	t.Print(t.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if co.File != "" {
+		return nil
+	}
+
+	if co.Name == "" {
+		return fmt.Errorf(`"name" is required when "file" is not set`)
+	}
+
+	switch co.Type {
+	case "webhook":
+		if _, ok := scmTypes[strings.ToLower(co.SCM)]; !ok {
+			return fmt.Errorf(`"scm" should be one of "github", "gitlab" or "bitbucket"`)
+		}
+		co.SCM = strings.ToLower(co.SCM)
+		if co.Connector == "" {
+			return fmt.Errorf(`"connector" is required for "webhook" triggers`)
+		}
+		if co.ConnectorScope == "account" && co.Repo == "" {
+			return fmt.Errorf(`"repo" is required with account level connectors`)
+		}
+	case "cron":
+		if len(strings.Fields(co.Expression)) < 5 {
+			return fmt.Errorf(`"expression" should be a cron expression e.g. "0 2 * * *"`)
+		}
+	default:
+		return fmt.Errorf(`"type" should be one of "webhook" or "cron", use "file" for the other triggers`)
+	}
+
+	return nil
+}
+
+var newCommandExample = fmt.Sprintf(`
+# Create a GitHub pull request trigger using the GitHub connector "github"
+%[1]s trigger new --name pr --pipeline my_pipeline --account-id <your account id> --project-id <project id> --connector github --actions Open,Reopen,Synchronize
+# Create a GitLab push trigger using the account level connector "gitlab" with the input set "staging"
+%[1]s trigger new --name push --pipeline my_pipeline --account-id <your account id> --project-id <project id> --scm gitlab --event Push --connector gitlab --connector-scope account --repo my-repo --input-set staging
+# Create a cron trigger that runs the pipeline every night at 2 AM
+%[1]s trigger new --name nightly --pipeline my_pipeline --account-id <your account id> --project-id <project id> --type cron --expression "0 2 * * *"
+# Create an artifact or a manifest trigger from the YAML file
+%[1]s trigger new --pipeline my_pipeline --account-id <your account id> --project-id <project id> -f artifact-trigger.yaml
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	tCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new webhook, cron or YAML defined trigger for the pipeline.",
+		Example: newCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(tCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*Trigger)(nil)
@@ -0,0 +1,103 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	triggersURL      = "https://app.harness.io/gateway/pipeline/api/triggers"
+	triggerURL       = "https://app.harness.io/gateway/pipeline/api/triggers/{id}"
+	triggerStatusURL = "https://app.harness.io/gateway/pipeline/api/triggers/{id}/status"
+)
+
+// PipelineOptions holds the project and the pipeline flags shared by the trigger commands
+type PipelineOptions struct {
+	types.ScopeOptions
+	// The identifier of the pipeline of the triggers
+	Pipeline string
+}
+
+// AddFlags adds the "pipeline" and "project-id" flags to the command
+func (po *PipelineOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&po.Pipeline, "pipeline", "", "", "The identifier of the pipeline of the trigger.")
+	cmd.MarkFlagRequired("pipeline")
+	po.ScopeOptions.AddProjectFlags(cmd)
+}
+
+// target returns the Target of the pipeline
+func (po *PipelineOptions) target() Target {
+	return Target{
+		ScopedResource: po.Resource(),
+		PipelineID:     po.Pipeline,
+	}
+}
+
+// Target holds the pipeline the triggers run
+type Target struct {
+	types.ScopedResource
+	// The identifier of the pipeline
+	PipelineID string
+}
+
+// request builds the HTTP request scoped to the pipeline of the trigger
+func (t *Target) request() *resty.Request {
+	req := t.Request()
+	req.SetQueryParam("targetIdentifier", t.PipelineID)
+	return req
+}
+
+// webhookURL returns the URL to register in the SCM or to call for the webhook trigger,
+// empty when the trigger is not a webhook trigger
+func (t *Target) webhookURL(triggerID, triggerType, webhookType string) string {
+	if triggerType != "Webhook" {
+		return ""
+	}
+
+	if webhookType == "Custom" || webhookType == "CUSTOM" {
+		return fmt.Sprintf("https://app.harness.io/gateway/pipeline/api/webhook/custom/v2?accountIdentifier=%s&orgIdentifier=%s&projectIdentifier=%s&pipelineIdentifier=%s&triggerIdentifier=%s",
+			t.AccountID, t.OrgID, t.ProjectID, t.PipelineID, triggerID)
+	}
+
+	return fmt.Sprintf("https://app.harness.io/gateway/ng/api/webhook?accountIdentifier=%s", t.AccountID)
+}
+
+// sourceTypes returns the source type e.g. "Webhook", "Scheduled" and the
+// source spec type e.g. "Github", "Cron" of the trigger YAML
+func sourceTypes(triggerYAML string) (string, string) {
+	var t struct {
+		Trigger struct {
+			Source struct {
+				Type string `yaml:"type"`
+				Spec struct {
+					Type string `yaml:"type"`
+				} `yaml:"spec"`
+			} `yaml:"source"`
+		} `yaml:"trigger"`
+	}
+
+	if err := yaml.Unmarshal([]byte(triggerYAML), &t); err != nil {
+		return "", ""
+	}
+
+	return t.Trigger.Source.Type, t.Trigger.Source.Spec.Type
+}
+
+// printWebhookURL prints the webhook URL of the trigger in the response
+func (t *Target) printWebhookURL(rm map[string]interface{}) {
+	data, ok := rm["data"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	y, _ := data["yaml"].(string)
+	id, _ := data["identifier"].(string)
+	triggerType, webhookType := sourceTypes(y)
+	if url := t.webhookURL(id, triggerType, webhookType); url != "" {
+		fmt.Printf("Webhook URL: %s\n", url)
+	}
+}
@@ -0,0 +1,111 @@
+package trigger
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type StatusOptions struct {
+	PipelineOptions
+	Name string
+	// "enable" or "disable"
+	action string
+}
+
+// TriggerStatus enables or disables the trigger
+type TriggerStatus struct {
+	Target
+	// The identifier of the trigger
+	Identifier string
+	Enabled    bool
+}
+
+// Call implements types.RESTCall
+func (ts *TriggerStatus) Call() (map[string]interface{}, error) {
+	req := ts.request()
+	req.SetPathParam("id", ts.Identifier)
+	req.SetQueryParam("status", strconv.FormatBool(ts.Enabled))
+	return utils.PutJSON(req, triggerStatusURL, nil)
+}
+
+// Print implements types.RESTCall
+func (ts *TriggerStatus) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	status := "disabled"
+	if ts.Enabled {
+		status = "enabled"
+	}
+	fmt.Printf("Trigger \"%s\" %s successfully\n", ts.Identifier, status)
+}
+
+// AddFlags implements types.Command
+func (so *StatusOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&so.Name, "name", "n", "", fmt.Sprintf("The identifier of the trigger to %s.", so.action))
+	cmd.MarkFlagRequired("name")
+	so.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (so *StatusOptions) Execute(cmd *cobra.Command, args []string) error {
+	ts := &TriggerStatus{
+		Target:     so.target(),
+		Identifier: so.Name,
+		Enabled:    so.action == "enable",
+	}
+
+	ts.Print(ts.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (so *StatusOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var statusCommandExamples = map[string]string{
+	"enable": fmt.Sprintf(`
+# Enable the trigger
+%[1]s trigger enable --name nightly --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"disable": fmt.Sprintf(`
+# Disable the trigger
+%[1]s trigger disable --name nightly --pipeline my_pipeline --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+}
+
+var statusCommandShorts = map[string]string{
+	"enable":  "Enables a trigger of the pipeline.",
+	"disable": "Disables a trigger of the pipeline.",
+}
+
+// newStatusCommands instantiates the new instances of the trigger enable and disable commands
+func newStatusCommands() []*cobra.Command {
+	cmds := []*cobra.Command{}
+	for _, action := range []string{"enable", "disable"} {
+		so := &StatusOptions{action: action}
+		sCmd := &cobra.Command{
+			Use:     action,
+			Short:   statusCommandShorts[action],
+			Example: statusCommandExamples[action],
+			RunE:    so.Execute,
+			PreRunE: so.Validate,
+		}
+		so.AddFlags(sCmd)
+		cmds = append(cmds, sCmd)
+	}
+	return cmds
+}
+
+var _ types.Command = (*StatusOptions)(nil)
+var _ types.RESTCall = (*TriggerStatus)(nil)
@@ -0,0 +1,97 @@
+package trigger
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/pipeline"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type UpdateOptions struct {
+	PipelineOptions
+	// The trigger YAML file
+	File string
+}
+
+// UpdateTrigger updates the trigger with its YAML
+type UpdateTrigger struct {
+	Trigger
+}
+
+// Call implements types.RESTCall
+func (ut *UpdateTrigger) Call() (map[string]interface{}, error) {
+	req := ut.request()
+	req.SetPathParam("id", ut.Identifier)
+	return utils.PutYAML(req, triggerURL, ut.YAML)
+}
+
+// Print implements types.RESTCall
+func (ut *UpdateTrigger) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Trigger \"%s\" updated successfully\n", ut.Identifier)
+	ut.printWebhookURL(rm)
+}
+
+// AddFlags implements types.Command
+func (uo *UpdateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&uo.File, "file", "f", "", "The trigger YAML file, the trigger is identified by its identifier.")
+	cmd.MarkFlagRequired("file")
+	uo.PipelineOptions.AddFlags(cmd)
+}
+
+// Execute implements types.Command
+func (uo *UpdateOptions) Execute(cmd *cobra.Command, args []string) error {
+	b, id, err := pipeline.ReadYAML(uo.File, "trigger")
+	if err != nil {
+		return err
+	}
+
+	ut := &UpdateTrigger{
+		Trigger: Trigger{
+			Target:     uo.target(),
+			Identifier: id,
+			YAML:       b,
+		},
+	}
+
+	ut.Print(ut.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (uo *UpdateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return nil
+}
+
+var updateCommandExample = fmt.Sprintf(`
+# Update the trigger with the YAML file
+%[1]s trigger update --pipeline my_pipeline --account-id <your account id> --project-id <project id> -f trigger.yaml
+`, common.ExamplePrefix())
+
+// newUpdateCommand instantiates the new instance of the newUpdateCommand
+func newUpdateCommand() *cobra.Command {
+	uo := &UpdateOptions{}
+
+	tCmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Updates the trigger with its YAML.",
+		Example: updateCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(tCmd)
+
+	return tCmd
+}
+
+var _ types.Command = (*UpdateOptions)(nil)
+var _ types.RESTCall = (*UpdateTrigger)(nil)
@@ -47,7 +47,7 @@ func (ci *ConnectorInfo) Print(rm map[string]interface{}, err error) {
 		fmt.Printf("%s Connector with name '%s' created with ID '%s' ", ci.ConnectorInfo.Type, ci.ConnectorInfo.Name, conn["identifier"].(string))
 	} else {
 		if v, ok := rm["code"]; ok && v == "DUPLICATE_FIELD" {
-			fmt.Printf("GitHub Connector with name '%s' already exists", ci.ConnectorInfo.Name)
+			fmt.Printf("%s Connector with name '%s' already exists", ci.ConnectorInfo.Type, ci.ConnectorInfo.Name)
 			return
 		}
 		log.Errorf("%#v", rm)
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// ScopeOptions holds the scope flags shared by the commands of the resources
+// that can be created at "account", "org" or "project" scope
+type ScopeOptions struct {
+	// The project identifier used to identify the project
+	ProjectID string
+	// The scope of the resource "account", "org" or "project"
+	Scope string
+}
+
+// AddFlags adds the "<kind>-scope" and "project-id" flags to the command e.g. "service-scope"
+func (so *ScopeOptions) AddFlags(cmd *cobra.Command, kind string) {
+	cmd.Flags().StringVarP(&so.Scope, kind+"-scope", "", "project", fmt.Sprintf(`The scope of the %s. Valid value is one of "project", "org", "account"`, kind))
+	cmd.Flags().StringVarP(&so.ProjectID, "project-id", "p", "", fmt.Sprintf(`The project of the %s. Used only when "%s-scope" is "project"`, kind, kind))
+}
+
+// AddProjectFlags adds the required "project-id" flag to the command of the resources
+// that only exist at "project" scope like pipelines, input sets and triggers
+func (so *ScopeOptions) AddProjectFlags(cmd *cobra.Command) {
+	so.Scope = "project"
+	cmd.Flags().StringVarP(&so.ProjectID, "project-id", "p", "", `The project of the resource.`)
+	cmd.MarkFlagRequired("project-id")
+}
+
+// Validate checks the scope and the project of the "project" scope
+func (so *ScopeOptions) Validate() error {
+	switch so.Scope {
+	case "project":
+		if so.ProjectID == "" {
+			return fmt.Errorf(`"project-id" is required for the "project" scope`)
+		}
+	case "org", "account":
+	default:
+		return fmt.Errorf(`scope should be one of "project", "org" or "account"`)
+	}
+	return nil
+}
+
+// ScopedIDs returns the organization and project identifiers for the scope
+func (so *ScopeOptions) ScopedIDs() (orgID, projectID string) {
+	if so.Scope == "project" {
+		return viper.GetString("org-id"), so.ProjectID
+	} else if so.Scope == "org" {
+		return viper.GetString("org-id"), ""
+	}
+	return "", ""
+}
+
+// Resource returns the ScopedResource using the configured API key, account and organization
+func (so *ScopeOptions) Resource() ScopedResource {
+	r := ScopedResource{
+		APIKey:    viper.GetString("api-key"),
+		AccountID: viper.GetString("account-id"),
+		Scope:     so.Scope,
+	}
+	r.OrgID, r.ProjectID = so.ScopedIDs()
+
+	return r
+}
+
+// ScopedResource holds the account, organization and project of a resource
+// that can be created at "account", "org" or "project" scope
+type ScopedResource struct {
+	// APIKey holds the API Key for the API calls
+	APIKey string `json:"-"`
+	// AccountID holds the AccountID that will be used for API calls
+	AccountID string `json:"-"`
+	// OrgID the organisation identifier, empty for the "account" scope
+	OrgID string `json:"-"`
+	// ProjectID the project identifier, empty for the "account" and "org" scope
+	ProjectID string `json:"-"`
+	// Scope of the resource "account", "org" or "project"
+	Scope string `json:"-"`
+}
+
+// Request builds the HTTP request scoped to the organization and project of the resource
+func (r *ScopedResource) Request() *resty.Request {
+	req := utils.NewHTTPRequest(r.APIKey, r.AccountID)
+	utils.AddScopedIDQueryParams(req, r.Scope, r.OrgID, r.ProjectID)
+	return req
+}
+
+// ScopedName returns the reference of the resource identifier at the scope
+// e.g. "account.foo", "org.foo" or "foo"
+func (r *ScopedResource) ScopedName(name string) string {
+	if r.Scope == "account" || r.Scope == "org" {
+		return fmt.Sprintf("%s.%s", r.Scope, name)
+	}
+	return name
+}
+
+// PrintError prints the error or the error response, returns true when there was one
+func PrintError(rm map[string]interface{}, err error) bool {
+	if err != nil {
+		log.Errorf("%s", err)
+		return true
+	}
+
+	log.Tracef("%#v", rm)
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		if m, ok := rm["message"]; ok {
+			fmt.Println(m)
+		} else {
+			log.Errorf("%#v", rm)
+		}
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright © 2022  Kamesh Sampath <kamesh.sampath@hotmail.com>
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *         http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ *  limitations under the License.
+ */
+
+package user
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewUserCommands is parent for all the "user" resource commands
+func NewUserCommands() *cobra.Command {
+	uCmd := &cobra.Command{
+		Use:              "user",
+		Short:            "Group of commands to invite, list and remove the users.",
+		TraverseChildren: true,
+	}
+
+	//Commands
+	uCmd.AddCommand(newInviteCommand())
+	uCmd.AddCommand(newListCommand())
+	uCmd.AddCommand(newRemoveCommand())
+
+	return uCmd
+}
@@ -0,0 +1,5 @@
+package user
+
+// user package has the commands that will be used to invite the users to an account,
+// organization or project with their roles, to list them and to remove them.
+// Refer to https://apidocs.harness.io/tag/User for API
@@ -0,0 +1,224 @@
+package user
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type InviteOptions struct {
+	types.ScopeOptions
+	// The emails of the users to invite
+	Emails []string
+	// The identifier of the role to bind to the users
+	Role string
+	// The identifier of the resource group of the role binding
+	ResourceGroup string
+	// The identifiers of the user groups to add the users to
+	UserGroups []string
+	// The CSV file of the emails and roles to invite
+	File string
+}
+
+// Invitation invites the users with their role bindings
+type Invitation struct {
+	types.ScopedResource
+	Emails       []string      `json:"emails"`
+	RoleBindings []RoleBinding `json:"roleBindings,omitempty"`
+	UserGroups   []string      `json:"userGroups,omitempty"`
+}
+
+// RoleBinding binds the role to the user for the resource group
+type RoleBinding struct {
+	RoleIdentifier          string `json:"roleIdentifier"`
+	ResourceGroupIdentifier string `json:"resourceGroupIdentifier"`
+	// true for the built-in roles e.g. "_project_viewer"
+	ManagedRole bool `json:"managedRole"`
+}
+
+// Call implements types.RESTCall
+func (i *Invitation) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(i.Request(), usersURL, i)
+}
+
+// Print implements types.RESTCall
+func (i *Invitation) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	data, _ := rm["data"].(map[string]interface{})
+	if res, ok := data["addUserResponseMap"].(map[string]interface{}); ok {
+		for _, email := range i.Emails {
+			fmt.Printf("%s: %v\n", email, res[email])
+		}
+	}
+}
+
+// roleBinding returns the binding of the role, the resource group defaults to all the resources of the scope
+func (ivo *InviteOptions) roleBinding(role, resourceGroup string) RoleBinding {
+	if resourceGroup == "" {
+		resourceGroup = DefaultResourceGroup(ivo.Scope)
+	}
+	return RoleBinding{
+		RoleIdentifier:          role,
+		ResourceGroupIdentifier: resourceGroup,
+		ManagedRole:             strings.HasPrefix(role, "_"),
+	}
+}
+
+// readInvitations reads the CSV file with the columns "email", "role" and optional "resource group",
+// the rows of the same email are merged into one invitation, the first row is skipped when it is a header
+func (ivo *InviteOptions) readInvitations(r types.ScopedResource) ([]*Invitation, error) {
+	f, err := os.Open(ivo.File)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cr := csv.NewReader(f)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	invitations := map[string]*Invitation{}
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		email := strings.TrimSpace(rec[0])
+		if email == "" || (line == 1 && strings.EqualFold(email, "email")) {
+			continue
+		}
+		if !strings.Contains(email, "@") {
+			return nil, fmt.Errorf(`"%s" line %d has invalid email "%s"`, ivo.File, line, email)
+		}
+
+		i, ok := invitations[email]
+		if !ok {
+			i = &Invitation{
+				ScopedResource: r,
+				Emails:         []string{email},
+				UserGroups:     ivo.UserGroups,
+			}
+			invitations[email] = i
+		}
+
+		role, resourceGroup := ivo.Role, ivo.ResourceGroup
+		if len(rec) > 1 && strings.TrimSpace(rec[1]) != "" {
+			role = strings.TrimSpace(rec[1])
+		}
+		if len(rec) > 2 && strings.TrimSpace(rec[2]) != "" {
+			resourceGroup = strings.TrimSpace(rec[2])
+		}
+		if role != "" {
+			i.RoleBindings = append(i.RoleBindings, ivo.roleBinding(role, resourceGroup))
+		}
+	}
+
+	emails := make([]string, 0, len(invitations))
+	for email := range invitations {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+
+	res := make([]*Invitation, 0, len(emails))
+	for _, email := range emails {
+		res = append(res, invitations[email])
+	}
+	return res, nil
+}
+
+// AddFlags implements types.Command
+func (ivo *InviteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&ivo.Emails, "email", "e", []string{}, "The emails of the users to invite.")
+	cmd.Flags().StringVarP(&ivo.Role, "role", "r", "", `The identifier of the role to bind to the users e.g. "_project_viewer".`)
+	cmd.Flags().StringVarP(&ivo.ResourceGroup, "resource-group", "", "", "The identifier of the resource group of the role, defaults to all the resources of the scope.")
+	cmd.Flags().StringSliceVarP(&ivo.UserGroups, "usergroup", "g", []string{}, "The identifiers of the user groups to add the users to.")
+	cmd.Flags().StringVarP(&ivo.File, "file", "f", "", `The CSV file of the users to invite with the columns "email", "role" and optional "resource group".`)
+	ivo.ScopeOptions.AddFlags(cmd, "user")
+}
+
+// Execute implements types.Command
+func (ivo *InviteOptions) Execute(cmd *cobra.Command, args []string) error {
+	r := ivo.Resource()
+
+	invitations := []*Invitation{}
+	if ivo.File != "" {
+		var err error
+		if invitations, err = ivo.readInvitations(r); err != nil {
+			return err
+		}
+	}
+
+	if len(ivo.Emails) > 0 {
+		i := &Invitation{
+			ScopedResource: r,
+			Emails:         ivo.Emails,
+			UserGroups:     ivo.UserGroups,
+		}
+		if ivo.Role != "" {
+			i.RoleBindings = []RoleBinding{ivo.roleBinding(ivo.Role, ivo.ResourceGroup)}
+		}
+		invitations = append(invitations, i)
+	}
+
+	for _, i := range invitations {
+		i.Print(i.Call())
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ivo *InviteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if len(ivo.Emails) == 0 && ivo.File == "" {
+		return fmt.Errorf(`one of "email" or "file" is required`)
+	}
+
+	return ivo.ScopeOptions.Validate()
+}
+
+var inviteCommandExample = fmt.Sprintf(`
+# Invite the users to the project as viewers
+%[1]s user invite --email alice@example.com,bob@example.com --role _project_viewer --account-id <your account id> --project-id <project id>
+# Invite the users to the account and add them to the user group
+%[1]s user invite --email alice@example.com --usergroup platform --account-id <your account id> --user-scope account
+# Invite the users of the CSV file, each line is "email,role[,resource group]"
+%[1]s user invite --file users.csv --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newInviteCommand instantiates the new instance of the newInviteCommand
+func newInviteCommand() *cobra.Command {
+	ivo := &InviteOptions{}
+
+	uCmd := &cobra.Command{
+		Use:     "invite",
+		Short:   "Invites the users with their roles.",
+		Example: inviteCommandExample,
+		RunE:    ivo.Execute,
+		PreRunE: ivo.Validate,
+	}
+
+	ivo.AddFlags(uCmd)
+
+	return uCmd
+}
+
+var _ types.Command = (*InviteOptions)(nil)
+var _ types.RESTCall = (*Invitation)(nil)
@@ -0,0 +1,122 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+	// The name or email that will be used to search the users
+	Search string
+}
+
+// ListUsers lists the users of the scope with their roles
+type ListUsers struct {
+	types.ScopedResource
+	// The name or email that will be used to search the users
+	Search string
+}
+
+// Call implements types.RESTCall
+func (lu *ListUsers) Call() (map[string]interface{}, error) {
+	return listUsers(lu.ScopedResource, lu.Search)
+}
+
+// Print implements types.RESTCall
+func (lu *ListUsers) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data, _ := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			ua := c.(map[string]interface{})
+			u, ok := ua["user"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			roles := []map[string]interface{}{}
+			if ras, ok := ua["roleAssignmentMetadata"].([]interface{}); ok {
+				for _, ra := range ras {
+					m := ra.(map[string]interface{})
+					roles = append(roles, map[string]interface{}{
+						"role":          m["roleIdentifier"],
+						"resourceGroup": m["resourceGroupIdentifier"],
+					})
+				}
+			}
+
+			resMap = append(resMap, map[string]interface{}{
+				"name":   u["name"],
+				"email":  u["email"],
+				"id":     u["uuid"],
+				"locked": u["locked"],
+				"roles":  roles,
+			})
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lo.Search, "search", "s", "", "The name or email to search the users.")
+	lo.ScopeOptions.AddFlags(cmd, "user")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lu := &ListUsers{
+		ScopedResource: lo.Resource(),
+		Search:         lo.Search,
+	}
+
+	lu.Print(lu.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the users of the project with their roles
+%[1]s user list --account-id <your account id> --project-id <project id>
+# Search the users of the account
+%[1]s user list --search alice --account-id <your account id> --user-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	uCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the users with their roles.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(uCmd)
+
+	return uCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListUsers)(nil)
@@ -0,0 +1,99 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type RemoveOptions struct {
+	types.ScopeOptions
+	// The email of the user to remove
+	Email string
+}
+
+// RemoveUser removes the user from the scope
+type RemoveUser struct {
+	types.ScopedResource
+	// The identifier of the user
+	Identifier string
+	Email      string
+}
+
+// Call implements types.RESTCall
+func (ru *RemoveUser) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(ru.Request(), userURL, ru.Identifier)
+}
+
+// Print implements types.RESTCall
+func (ru *RemoveUser) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("User \"%s\" removed successfully\n", ru.Email)
+	}
+}
+
+// AddFlags implements types.Command
+func (ro *RemoveOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&ro.Email, "email", "e", "", "The email of the user to remove.")
+	cmd.MarkFlagRequired("email")
+	ro.ScopeOptions.AddFlags(cmd, "user")
+}
+
+// Execute implements types.Command
+func (ro *RemoveOptions) Execute(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	r := ro.Resource()
+	id, err := FindUserID(r, ro.Email)
+	if err != nil {
+		return err
+	}
+
+	ru := &RemoveUser{
+		ScopedResource: r,
+		Identifier:     id,
+		Email:          ro.Email,
+	}
+
+	ru.Print(ru.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ro *RemoveOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return ro.ScopeOptions.Validate()
+}
+
+var removeCommandExample = fmt.Sprintf(`
+# Remove the user from the project
+%[1]s user remove --email alice@example.com --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newRemoveCommand instantiates the new instance of the newRemoveCommand
+func newRemoveCommand() *cobra.Command {
+	ro := &RemoveOptions{}
+
+	uCmd := &cobra.Command{
+		Use:     "remove",
+		Short:   "Removes a user from the account, organization or project.",
+		Example: removeCommandExample,
+		RunE:    ro.Execute,
+		PreRunE: ro.Validate,
+	}
+
+	ro.AddFlags(uCmd)
+
+	return uCmd
+}
+
+var _ types.Command = (*RemoveOptions)(nil)
+var _ types.RESTCall = (*RemoveUser)(nil)
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+)
+
+const (
+	usersURL         = "https://app.harness.io/gateway/ng/api/user/users"
+	userURL          = "https://app.harness.io/gateway/ng/api/user/{id}"
+	userAggregateURL = "https://app.harness.io/gateway/ng/api/user/aggregate"
+)
+
+// listUsers lists the users of the scope with their role assignments,
+// the users are filtered by the search term when not empty
+func listUsers(r types.ScopedResource, search string) (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := r.Request()
+		req.SetQueryParam("pageIndex", fmt.Sprint(index))
+		req.SetQueryParam("pageSize", "100")
+		if search != "" {
+			req.SetQueryParam("searchTerm", search)
+		}
+		return utils.PostJSON(req, userAggregateURL, map[string]interface{}{})
+	}, nil, 0)
+}
+
+// FindUserID returns the identifier of the user with the email in the scope
+func FindUserID(r types.ScopedResource, email string) (string, error) {
+	rm, err := listUsers(r, email)
+	if err != nil {
+		return "", err
+	}
+	if v, ok := rm["status"]; !ok || v != "SUCCESS" {
+		return "", fmt.Errorf("%v", rm["message"])
+	}
+
+	data, _ := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			u, ok := c.(map[string]interface{})["user"].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if e, _ := u["email"].(string); strings.EqualFold(e, email) {
+				id, _ := u["uuid"].(string)
+				return id, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf(`user with email "%s" does not exist`, email)
+}
+
+// DefaultResourceGroup returns the resource group of all the resources of the scope
+func DefaultResourceGroup(scope string) string {
+	switch scope {
+	case "account":
+		return "_all_account_level_resources"
+	case "org":
+		return "_all_organization_level_resources"
+	default:
+		return "_all_project_level_resources"
+	}
+}
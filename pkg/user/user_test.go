@@ -0,0 +1,98 @@
+package user
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kameshsampath/harness-cli/pkg/types"
+)
+
+func TestReadInvitations(t *testing.T) {
+	tests := []struct {
+		name    string
+		csv     string
+		role    string
+		want    map[string][]RoleBinding
+		wantErr bool
+	}{
+		{
+			name: "header and merged emails",
+			csv: `email,role,resource group
+bob@example.com,_project_viewer
+alice@example.com,_project_admin,my_group
+bob@example.com,deployer
+`,
+			want: map[string][]RoleBinding{
+				"alice@example.com": {
+					{RoleIdentifier: "_project_admin", ResourceGroupIdentifier: "my_group", ManagedRole: true},
+				},
+				"bob@example.com": {
+					{RoleIdentifier: "_project_viewer", ResourceGroupIdentifier: "_all_project_level_resources", ManagedRole: true},
+					{RoleIdentifier: "deployer", ResourceGroupIdentifier: "_all_project_level_resources"},
+				},
+			},
+		},
+		{
+			name: "default role",
+			csv: `alice@example.com
+bob@example.com, , my_group
+`,
+			role: "_project_viewer",
+			want: map[string][]RoleBinding{
+				"alice@example.com": {
+					{RoleIdentifier: "_project_viewer", ResourceGroupIdentifier: "_all_project_level_resources", ManagedRole: true},
+				},
+				"bob@example.com": {
+					{RoleIdentifier: "_project_viewer", ResourceGroupIdentifier: "my_group", ManagedRole: true},
+				},
+			},
+		},
+		{
+			name: "no role",
+			csv:  "alice@example.com\n",
+			want: map[string][]RoleBinding{
+				"alice@example.com": nil,
+			},
+		},
+		{
+			name:    "invalid email",
+			csv:     "email,role\nalice,_project_viewer\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "users.csv")
+			if err := os.WriteFile(file, []byte(tt.csv), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			ivo := &InviteOptions{
+				ScopeOptions: types.ScopeOptions{Scope: "project"},
+				Role:         tt.role,
+				File:         file,
+			}
+			invitations, err := ivo.readInvitations(types.ScopedResource{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := map[string][]RoleBinding{}
+			for _, i := range invitations {
+				if len(i.Emails) != 1 {
+					t.Fatalf("got emails %v, want one email per invitation", i.Emails)
+				}
+				got[i.Emails[0]] = i.RoleBindings
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
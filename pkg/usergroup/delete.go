@@ -0,0 +1,88 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// DeleteUserGroup deletes the user group
+type DeleteUserGroup struct {
+	types.ScopedResource
+	// The identifier of the user group
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (dg *DeleteUserGroup) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(dg.Request(), userGroupURL, dg.Identifier)
+}
+
+// Print implements types.RESTCall
+func (dg *DeleteUserGroup) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("User group \"%s\" deleted successfully\n", dg.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the user group to delete.")
+	cmd.MarkFlagRequired("name")
+	do.ScopeOptions.AddFlags(cmd, "usergroup")
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	dg := &DeleteUserGroup{
+		ScopedResource: do.Resource(),
+		Identifier:     do.Name,
+	}
+
+	dg.Print(dg.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the project user group
+%[1]s usergroup delete --name developers --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	gCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a user group.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(gCmd)
+
+	return gCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteUserGroup)(nil)
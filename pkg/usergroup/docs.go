@@ -0,0 +1,5 @@
+package usergroup
+
+// usergroup package has the commands that will be used to manipulate the user groups of an
+// account, organization or project, their members and their link to the SAML or LDAP groups.
+// Refer to https://apidocs.harness.io/tag/User-Group for API
@@ -0,0 +1,91 @@
+package usergroup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// GetUserGroup gets the user group
+type GetUserGroup struct {
+	types.ScopedResource
+	// The identifier of the user group
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (gg *GetUserGroup) Call() (map[string]interface{}, error) {
+	return utils.GetResourceByID(gg.Request(), userGroupURL, gg.Identifier)
+}
+
+// Print implements types.RESTCall
+func (gg *GetUserGroup) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if ug, ok := rm["data"].(map[string]interface{}); ok {
+		en := json.NewEncoder(os.Stdout)
+		en.Encode(userGroupSummary(ug))
+	}
+}
+
+// AddFlags implements types.Command
+func (ggo *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&ggo.Name, "name", "n", "", "The identifier of the user group.")
+	cmd.MarkFlagRequired("name")
+	ggo.ScopeOptions.AddFlags(cmd, "usergroup")
+}
+
+// Execute implements types.Command
+func (ggo *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gg := &GetUserGroup{
+		ScopedResource: ggo.Resource(),
+		Identifier:     ggo.Name,
+	}
+
+	gg.Print(gg.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (ggo *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return ggo.ScopeOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the project user group
+%[1]s usergroup get --name developers --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	ggo := &GetOptions{}
+
+	gCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets a user group with its members.",
+		Example: getCommandExample,
+		RunE:    ggo.Execute,
+		PreRunE: ggo.Validate,
+	}
+
+	ggo.AddFlags(gCmd)
+
+	return gCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetUserGroup)(nil)
@@ -0,0 +1,99 @@
+package usergroup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+}
+
+// ListUserGroups lists the user groups of the scope
+type ListUserGroups struct {
+	types.ScopedResource
+}
+
+// Call implements types.RESTCall
+func (lg *ListUserGroups) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lg.Request()
+		req.SetQueryParam("pageIndex", fmt.Sprint(index))
+		req.SetQueryParam("pageSize", "100")
+		return utils.GetJSON(req, userGroupsURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lg *ListUserGroups) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data, _ := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			resMap = append(resMap, userGroupSummary(c.(map[string]interface{})))
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	lo.ScopeOptions.AddFlags(cmd, "usergroup")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lg := &ListUserGroups{
+		ScopedResource: lo.Resource(),
+	}
+
+	lg.Print(lg.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the user groups of the project
+%[1]s usergroup list --account-id <your account id> --project-id <project id>
+# List the account user groups
+%[1]s usergroup list --account-id <your account id> --usergroup-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	gCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the user groups.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(gCmd)
+
+	return gCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListUserGroups)(nil)
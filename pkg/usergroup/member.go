@@ -0,0 +1,137 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/user"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type MemberOptions struct {
+	types.ScopeOptions
+	// The identifier of the user group
+	Name string
+	// The emails of the users to add or remove
+	Emails []string
+	// "add-member" or "remove-member"
+	action string
+}
+
+// Member adds the user to or removes the user from the user group
+type Member struct {
+	types.ScopedResource
+	// The identifier of the user group
+	Identifier string
+	// The identifier of the user
+	UserID string
+	Email  string
+	// whether to remove the user from the user group
+	remove bool
+}
+
+// Call implements types.RESTCall
+func (m *Member) Call() (map[string]interface{}, error) {
+	req := m.Request()
+	req.SetPathParams(map[string]string{
+		"id":     m.Identifier,
+		"userId": m.UserID,
+	})
+	if m.remove {
+		return utils.DeleteJSON(req, memberURL)
+	}
+	return utils.PutJSON(req, memberURL, nil)
+}
+
+// Print implements types.RESTCall
+func (m *Member) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	if m.remove {
+		fmt.Printf("User \"%s\" removed from user group \"%s\" successfully\n", m.Email, m.Identifier)
+		return
+	}
+	fmt.Printf("User \"%s\" added to user group \"%s\" successfully\n", m.Email, m.Identifier)
+}
+
+// AddFlags implements types.Command
+func (mo *MemberOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&mo.Name, "name", "n", "", "The identifier of the user group.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringSliceVarP(&mo.Emails, "email", "e", []string{}, "The emails of the users.")
+	cmd.MarkFlagRequired("email")
+	mo.ScopeOptions.AddFlags(cmd, "usergroup")
+}
+
+// Execute implements types.Command
+func (mo *MemberOptions) Execute(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	r := mo.Resource()
+	for _, email := range mo.Emails {
+		id, err := user.FindUserID(r, email)
+		if err != nil {
+			return err
+		}
+
+		m := &Member{
+			ScopedResource: r,
+			Identifier:     mo.Name,
+			UserID:         id,
+			Email:          email,
+			remove:         mo.action == "remove-member",
+		}
+
+		m.Print(m.Call())
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (mo *MemberOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return mo.ScopeOptions.Validate()
+}
+
+var memberCommandExamples = map[string]string{
+	"add-member": fmt.Sprintf(`
+# Add the users to the project user group
+%[1]s usergroup add-member --name developers --email alice@example.com,bob@example.com --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+	"remove-member": fmt.Sprintf(`
+# Remove the user from the project user group
+%[1]s usergroup remove-member --name developers --email bob@example.com --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix()),
+}
+
+var memberCommandShorts = map[string]string{
+	"add-member":    "Adds the users to a user group.",
+	"remove-member": "Removes the users from a user group.",
+}
+
+// newMemberCommands instantiates the new instances of the usergroup add-member and remove-member commands
+func newMemberCommands() []*cobra.Command {
+	cmds := []*cobra.Command{}
+	for _, action := range []string{"add-member", "remove-member"} {
+		mo := &MemberOptions{action: action}
+		mCmd := &cobra.Command{
+			Use:     action,
+			Short:   memberCommandShorts[action],
+			Example: memberCommandExamples[action],
+			RunE:    mo.Execute,
+			PreRunE: mo.Validate,
+		}
+		mo.AddFlags(mCmd)
+		cmds = append(cmds, mCmd)
+	}
+	return cmds
+}
+
+var _ types.Command = (*MemberOptions)(nil)
+var _ types.RESTCall = (*Member)(nil)
@@ -0,0 +1,209 @@
+package usergroup
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/user"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	Name        string
+	Description string
+	// The emails of the members of the user group
+	Members []string
+	// "saml" or "ldap", the user group is not linked to SSO when empty
+	SSOType string
+	// The identifier of the SAML or LDAP settings
+	SSOID string
+	// The SAML group name or the LDAP group DN
+	SSOGroup string
+	// The LDAP group name, defaults to the "SSOGroup"
+	SSOGroupName string
+}
+
+// UserGroup creates the user group
+type UserGroup struct {
+	types.ScopedResource
+	Identifier        string   `json:"identifier"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description,omitempty"`
+	AccountIdentifier string   `json:"accountIdentifier"`
+	OrgIdentifier     string   `json:"orgIdentifier,omitempty"`
+	ProjectIdentifier string   `json:"projectIdentifier,omitempty"`
+	Users             []string `json:"users,omitempty"`
+}
+
+// LinkSSO links the user group to the SAML or LDAP group
+type LinkSSO struct {
+	types.ScopedResource
+	// The identifier of the user group
+	Identifier string `json:"-"`
+	// "saml" or "ldap"
+	SSOType string `json:"-"`
+	// The identifier of the SAML or LDAP settings
+	SSOID         string `json:"-"`
+	SAMLGroupName string `json:"samlGroupName,omitempty"`
+	LDAPGroupDN   string `json:"ldapGroupDN,omitempty"`
+	LDAPGroupName string `json:"ldapGroupName,omitempty"`
+}
+
+// Call implements types.RESTCall
+func (ug *UserGroup) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(ug.Request(), userGroupsURL, ug)
+}
+
+// Print implements types.RESTCall
+func (ug *UserGroup) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("User group \"%s\" created successfully\n", ug.Identifier)
+}
+
+// Call implements types.RESTCall
+func (ls *LinkSSO) Call() (map[string]interface{}, error) {
+	req := ls.Request()
+	req.SetPathParams(map[string]string{
+		"id":    ls.Identifier,
+		"ssoId": ls.SSOID,
+	})
+	if ls.SSOType == "ldap" {
+		return utils.PutJSON(req, linkToLDAPURL, ls)
+	}
+	return utils.PutJSON(req, linkToSAMLURL, ls)
+}
+
+// Print implements types.RESTCall
+func (ls *LinkSSO) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	group := ls.SAMLGroupName
+	if ls.SSOType == "ldap" {
+		group = ls.LDAPGroupDN
+	}
+	fmt.Printf("User group \"%s\" linked to %s group \"%s\" successfully\n", ls.Identifier, ls.SSOType, group)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the user group.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&co.Description, "description", "d", "", "The description of the user group.")
+	cmd.Flags().StringSliceVarP(&co.Members, "member", "e", []string{}, "The emails of the members of the user group.")
+	cmd.Flags().StringVarP(&co.SSOType, "sso-type", "", "", `The SSO type to link the user group to. Valid value is one of "saml", "ldap"`)
+	cmd.Flags().StringVarP(&co.SSOID, "sso-id", "", "", `The identifier of the SAML or LDAP settings. Required when "sso-type" is set`)
+	cmd.Flags().StringVarP(&co.SSOGroup, "sso-group", "", "", `The SAML group name or the LDAP group DN. Required when "sso-type" is set`)
+	cmd.Flags().StringVarP(&co.SSOGroupName, "sso-group-name", "", "", `The LDAP group name, defaults to the "sso-group". Used only when "sso-type" is "ldap"`)
+	co.ScopeOptions.AddFlags(cmd, "usergroup")
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	cmd.SilenceUsage = true
+
+	r := co.Resource()
+	ug := &UserGroup{
+		ScopedResource:    r,
+		Identifier:        utils.IDFromName(co.Name),
+		Name:              co.Name,
+		Description:       co.Description,
+		AccountIdentifier: r.AccountID,
+		OrgIdentifier:     r.OrgID,
+		ProjectIdentifier: r.ProjectID,
+	}
+
+	for _, email := range co.Members {
+		id, err := user.FindUserID(r, email)
+		if err != nil {
+			return err
+		}
+		ug.Users = append(ug.Users, id)
+	}
+
+	rm, err := ug.Call()
+	ug.Print(rm, err)
+	if err != nil || rm["status"] != "SUCCESS" {
+		return fmt.Errorf(`unable to create the user group "%s"`, co.Name)
+	}
+	if co.SSOType == "" {
+		return nil
+	}
+
+	ls := &LinkSSO{
+		ScopedResource: r,
+		Identifier:     ug.Identifier,
+		SSOType:        co.SSOType,
+		SSOID:          co.SSOID,
+	}
+	if co.SSOType == "ldap" {
+		ls.LDAPGroupDN = co.SSOGroup
+		ls.LDAPGroupName = co.SSOGroupName
+		if ls.LDAPGroupName == "" {
+			ls.LDAPGroupName = co.SSOGroup
+		}
+	} else {
+		ls.SAMLGroupName = co.SSOGroup
+	}
+
+	rm, err = ls.Call()
+	ls.Print(rm, err)
+	if err != nil || rm["status"] != "SUCCESS" {
+		return fmt.Errorf(`user group "%s" was created but it is not linked to the %s group "%s"`, ug.Identifier, co.SSOType, co.SSOGroup)
+	}
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	switch co.SSOType {
+	case "":
+	case "saml", "ldap":
+		if co.SSOID == "" || co.SSOGroup == "" {
+			return fmt.Errorf(`"sso-id" and "sso-group" is required to link the user group to SSO`)
+		}
+	default:
+		return fmt.Errorf(`"sso-type" should be one of "saml" or "ldap"`)
+	}
+
+	return co.ScopeOptions.Validate()
+}
+
+var createCommandExample = fmt.Sprintf(`
+# Create the project user group with its members
+%[1]s usergroup new --name developers --member alice@example.com,bob@example.com --account-id <your account id> --project-id <project id>
+# Create the account user group linked to the SAML group
+%[1]s usergroup new --name platform --sso-type saml --sso-id <saml settings id> --sso-group platform-team --account-id <your account id> --usergroup-scope account
+# Create the account user group linked to the LDAP group
+%[1]s usergroup new --name platform --sso-type ldap --sso-id <ldap settings id> --sso-group "cn=platform,ou=groups,dc=example,dc=com" --account-id <your account id> --usergroup-scope account
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	gCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new user group, optionally linked to a SAML or LDAP group.",
+		Example: createCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(gCmd)
+
+	return gCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*UserGroup)(nil)
+var _ types.RESTCall = (*LinkSSO)(nil)
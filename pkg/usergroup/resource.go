@@ -0,0 +1,25 @@
+package usergroup
+
+const (
+	userGroupsURL = "https://app.harness.io/gateway/ng/api/user-groups"
+	userGroupURL  = "https://app.harness.io/gateway/ng/api/user-groups/{id}"
+	memberURL     = "https://app.harness.io/gateway/ng/api/user-groups/{id}/member/{userId}"
+	linkToSAMLURL = "https://app.harness.io/gateway/ng/api/user-groups/{id}/link-to-saml/{ssoId}"
+	linkToLDAPURL = "https://app.harness.io/gateway/ng/api/user-groups/{id}/link-to-ldap/{ssoId}"
+)
+
+// userGroupSummary returns the summary of the user group response used by the get and list commands
+func userGroupSummary(ug map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{
+		"name":        ug["name"],
+		"id":          ug["identifier"],
+		"description": ug["description"],
+		"users":       ug["users"],
+	}
+	if v, ok := ug["ssoLinked"].(bool); ok && v {
+		res["ssoType"] = ug["linkedSsoType"]
+		res["sso"] = ug["linkedSsoDisplayName"]
+		res["ssoGroup"] = ug["ssoGroupName"]
+	}
+	return res
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"fmt"
+
 	"github.com/go-resty/resty/v2"
 	log "github.com/sirupsen/logrus"
 )
@@ -63,6 +65,169 @@ func PostJSON(req *resty.Request, url string, body interface{}) (map[string]inte
 	return *resMap, nil
 }
 
+// PutJSON executes the PUT HTTP method to put the JSON(body)
+func PutJSON(req *resty.Request, url string, body interface{}) (map[string]interface{}, error) {
+	if body != nil {
+		req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Put(url)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("URL %s", resp.Request.URL)
+	log.Tracef("BODY %s", resp.Request.Body)
+
+	resMap := resp.Result().(*map[string]interface{})
+
+	return *resMap, nil
+}
+
+// PostYAML executes the POST HTTP method to post the YAML(body)
+func PostYAML(req *resty.Request, url string, body []byte) (map[string]interface{}, error) {
+	resp, err := req.
+		SetHeader("Content-Type", "application/yaml").
+		SetBody(body).
+		Post(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("URL %s", resp.Request.URL)
+	log.Tracef("BODY %s", body)
+
+	resMap := resp.Result().(*map[string]interface{})
+
+	return *resMap, nil
+}
+
+// PutYAML executes the PUT HTTP method to put the YAML(body)
+func PutYAML(req *resty.Request, url string, body []byte) (map[string]interface{}, error) {
+	resp, err := req.
+		SetHeader("Content-Type", "application/yaml").
+		SetBody(body).
+		Put(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("URL %s", resp.Request.URL)
+	log.Tracef("BODY %s", body)
+
+	resMap := resp.Result().(*map[string]interface{})
+
+	return *resMap, nil
+}
+
+// PostMultipart executes the POST HTTP method to post the multipart form data
+// with the files, the files map holds the form parameter name and the file path
+func PostMultipart(req *resty.Request, url string, files, formData map[string]string) (map[string]interface{}, error) {
+	return multipart(req, resty.MethodPost, url, files, formData)
+}
+
+// PutMultipart executes the PUT HTTP method to put the multipart form data
+// with the files, the files map holds the form parameter name and the file path
+func PutMultipart(req *resty.Request, url string, files, formData map[string]string) (map[string]interface{}, error) {
+	return multipart(req, resty.MethodPut, url, files, formData)
+}
+
+func multipart(req *resty.Request, method, url string, files, formData map[string]string) (map[string]interface{}, error) {
+	resp, err := req.
+		SetFiles(files).
+		SetMultipartFormData(formData).
+		Execute(method, url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("URL %s", resp.Request.URL)
+	log.Tracef("BODY %s", resp.Request.Body)
+
+	resMap := resp.Result().(*map[string]interface{})
+	log.Tracef("Response %#v", *resMap)
+
+	return *resMap, nil
+}
+
+// PostJSONForContent executes the POST HTTP method to post the JSON(body)
+// and returns the raw response content e.g. a file download
+func PostJSONForContent(req *resty.Request, url string, body interface{}) ([]byte, error) {
+	resp, err := req.
+		SetHeader("Content-Type", "application/json").
+		SetBody(body).
+		Post(url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("URL %s", resp.Request.URL)
+	log.Tracef("BODY %s", resp.Request.Body)
+
+	return contentOrError(resp)
+}
+
+// contentOrError returns the raw response content, if the response is an error
+// the error message from the response is returned as error
+func contentOrError(resp *resty.Response) ([]byte, error) {
+	if resp.IsError() {
+		resMap := resp.Error().(*map[string]interface{})
+		if m, ok := (*resMap)["message"]; ok {
+			return nil, fmt.Errorf("%v", m)
+		}
+		return nil, fmt.Errorf("%s %s", resp.Status(), resp.String())
+	}
+
+	return resp.Body(), nil
+}
+
+// GetJSON executes the GET HTTP method and returns the JSON response
+func GetJSON(req *resty.Request, url string) (map[string]interface{}, error) {
+	resp, err := req.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("URL %s", resp.Request.URL)
+
+	resMap := resp.Result().(*map[string]interface{})
+	log.Tracef("Response %#v", *resMap)
+
+	return *resMap, nil
+}
+
+// GetContent executes the GET HTTP method and returns the raw response content
+// e.g. a file download or plain text
+func GetContent(req *resty.Request, url string) ([]byte, error) {
+	resp, err := req.
+		ForceContentType("text/plain").
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Tracef("URL %s", resp.Request.URL)
+
+	return contentOrError(resp)
+}
+
+// GetResourceByID gets the resource by ID
+// The request url should have a path parameter named "{id}"
+func GetResourceByID(req *resty.Request, url, id string) (map[string]interface{}, error) {
+	req.
+		SetPathParams(map[string]string{
+			"id": id,
+		})
+
+	return GetJSON(req, url)
+}
+
 // DeleteResourceByID deletes the resource by ID
 // The request url should have a path parameter named "{id}"
 func DeleteResourceByID(req *resty.Request, url, id string) (map[string]interface{}, error) {
@@ -71,6 +236,11 @@ func DeleteResourceByID(req *resty.Request, url, id string) (map[string]interfac
 			"id": id,
 		})
 
+	return DeleteJSON(req, url)
+}
+
+// DeleteJSON executes the DELETE HTTP method and returns the JSON response
+func DeleteJSON(req *resty.Request, url string) (map[string]interface{}, error) {
 	resp, err := req.Delete(url)
 	if err != nil {
 		return nil, err
@@ -84,3 +254,37 @@ func DeleteResourceByID(req *resty.Request, url, id string) (map[string]interfac
 
 	return *resMap, nil
 }
+
+// ListPages calls the paginated list API page by page, from the page index 0 until the last page
+// or until limit items, when limit is greater than zero, are kept. A nil keep keeps all the items.
+// It returns the response of the last page with the kept items of all the pages as "data.content"
+func ListPages(page func(index int) (map[string]interface{}, error), keep func(item interface{}) bool, limit int) (map[string]interface{}, error) {
+	items := []interface{}{}
+	for i := 0; ; i++ {
+		rm, err := page(i)
+		if err != nil {
+			return rm, err
+		}
+
+		data, ok := rm["data"].(map[string]interface{})
+		if v := rm["status"]; v != "SUCCESS" || !ok {
+			return rm, nil
+		}
+
+		content, _ := data["content"].([]interface{})
+		for _, c := range content {
+			if limit > 0 && len(items) >= limit {
+				break
+			}
+			if keep == nil || keep(c) {
+				items = append(items, c)
+			}
+		}
+
+		totalPages, _ := data["totalPages"].(float64)
+		if (limit > 0 && len(items) >= limit) || len(content) == 0 || float64(i+1) >= totalPages {
+			data["content"] = items
+			return rm, nil
+		}
+	}
+}
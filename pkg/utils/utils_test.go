@@ -1 +1,146 @@
 package utils
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// pages returns a page func serving the items in pages of size, it records the called page indexes
+func pages(items []interface{}, size int, called *[]int) func(index int) (map[string]interface{}, error) {
+	return func(index int) (map[string]interface{}, error) {
+		*called = append(*called, index)
+		totalPages := (len(items) + size - 1) / size
+		content := []interface{}{}
+		for i := index * size; i < len(items) && i < (index+1)*size; i++ {
+			content = append(content, items[i])
+		}
+		return map[string]interface{}{
+			"status": "SUCCESS",
+			"data": map[string]interface{}{
+				"content":    content,
+				"totalPages": float64(totalPages),
+			},
+		}, nil
+	}
+}
+
+func TestListPages(t *testing.T) {
+	items := []interface{}{1, 2, 3, 4, 5, 6, 7}
+	even := func(item interface{}) bool { return item.(int)%2 == 0 }
+
+	tests := []struct {
+		name      string
+		items     []interface{}
+		keep      func(item interface{}) bool
+		limit     int
+		wantItems []interface{}
+		wantPages []int
+	}{
+		{
+			name:      "all pages",
+			items:     items,
+			wantItems: items,
+			wantPages: []int{0, 1, 2},
+		},
+		{
+			name:      "limit within the first page",
+			items:     items,
+			limit:     2,
+			wantItems: []interface{}{1, 2},
+			wantPages: []int{0},
+		},
+		{
+			name:      "limit across pages",
+			items:     items,
+			limit:     4,
+			wantItems: []interface{}{1, 2, 3, 4},
+			wantPages: []int{0, 1},
+		},
+		{
+			name:      "keep",
+			items:     items,
+			keep:      even,
+			wantItems: []interface{}{2, 4, 6},
+			wantPages: []int{0, 1, 2},
+		},
+		{
+			name:      "keep with limit",
+			items:     items,
+			keep:      even,
+			limit:     2,
+			wantItems: []interface{}{2, 4},
+			wantPages: []int{0, 1},
+		},
+		{
+			name:      "no items",
+			items:     []interface{}{},
+			wantItems: []interface{}{},
+			wantPages: []int{0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called []int
+			rm, err := ListPages(pages(tt.items, 3, &called), tt.keep, tt.limit)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			got := rm["data"].(map[string]interface{})["content"]
+			if !reflect.DeepEqual(got, tt.wantItems) {
+				t.Errorf("got items %v, want %v", got, tt.wantItems)
+			}
+			if !reflect.DeepEqual(called, tt.wantPages) {
+				t.Errorf("got pages %v, want %v", called, tt.wantPages)
+			}
+		})
+	}
+}
+
+func TestListPagesError(t *testing.T) {
+	tests := []struct {
+		name    string
+		rm      map[string]interface{}
+		err     error
+		wantErr bool
+	}{
+		{
+			name:    "error",
+			err:     fmt.Errorf("boom"),
+			wantErr: true,
+		},
+		{
+			name: "error response",
+			rm: map[string]interface{}{
+				"status":  "ERROR",
+				"message": "boom",
+			},
+		},
+		{
+			name: "no data",
+			rm: map[string]interface{}{
+				"status": "SUCCESS",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			rm, err := ListPages(func(index int) (map[string]interface{}, error) {
+				calls++
+				return tt.rm, tt.err
+			}, nil, 0)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(rm, tt.rm) {
+				t.Errorf("got response %v, want %v", rm, tt.rm)
+			}
+			if calls != 1 {
+				t.Errorf("got %d calls, want 1", calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReadYAMLResource reads the YAML file of a resource and returns its content with
+// the fields under the root key e.g. "pipeline", the fields must have an "identifier"
+func ReadYAMLResource(file, root string) ([]byte, map[string]interface{}, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var doc map[string]map[string]interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return nil, nil, err
+	}
+
+	r, ok := doc[root]
+	if !ok {
+		return nil, nil, fmt.Errorf(`"%s" is not a %s YAML, missing the "%s" root`, file, root, root)
+	}
+
+	if id, _ := r["identifier"].(string); id == "" {
+		return nil, nil, fmt.Errorf(`"%s" has no "%s.identifier"`, file, root)
+	}
+
+	return b, r, nil
+}
+
+// StringValue returns the string value of the key, empty when the key does not exist
+func StringValue(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// TagsValue returns the tags under the key "tags" of the YAML resource fields
+func TagsValue(m map[string]interface{}) map[string]string {
+	tm := map[string]string{}
+	if tags, ok := m["tags"].(map[string]interface{}); ok {
+		for k, v := range tags {
+			tm[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	return tm
+}
+
+// MappingValue returns the value of key from the mapping node n, nil if not found
+func MappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package variable
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type DeleteOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// DeleteVariable deletes the variable
+type DeleteVariable struct {
+	types.ScopedResource
+	// The identifier of the variable
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (dv *DeleteVariable) Call() (map[string]interface{}, error) {
+	return utils.DeleteResourceByID(dv.Request(), variableURL, dv.Identifier)
+}
+
+// Print implements types.RESTCall
+func (dv *DeleteVariable) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	if v, ok := rm["data"].(bool); ok && v {
+		fmt.Printf("Variable \"%s\" deleted successfully\n", dv.Identifier)
+	}
+}
+
+// AddFlags implements types.Command
+func (do *DeleteOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&do.Name, "name", "n", "", "The identifier of the variable to delete.")
+	cmd.MarkFlagRequired("name")
+	do.ScopeOptions.AddFlags(cmd, "variable")
+}
+
+// Execute implements types.Command
+func (do *DeleteOptions) Execute(cmd *cobra.Command, args []string) error {
+	dv := &DeleteVariable{
+		ScopedResource: do.Resource(),
+		Identifier:     do.Name,
+	}
+
+	dv.Print(dv.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (do *DeleteOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return do.ScopeOptions.Validate()
+}
+
+var deleteCommandExample = fmt.Sprintf(`
+# Delete the project variable
+%[1]s variable delete --name region --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newDeleteCommand instantiates the new instance of the newDeleteCommand
+func newDeleteCommand() *cobra.Command {
+	do := &DeleteOptions{}
+
+	vCmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Deletes a variable.",
+		Example: deleteCommandExample,
+		RunE:    do.Execute,
+		PreRunE: do.Validate,
+	}
+
+	do.AddFlags(vCmd)
+
+	return vCmd
+}
+
+var _ types.Command = (*DeleteOptions)(nil)
+var _ types.RESTCall = (*DeleteVariable)(nil)
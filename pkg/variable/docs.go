@@ -0,0 +1,6 @@
+package variable
+
+// variable package has the commands that will be used to manipulate the Harness variables
+// at account, organization or project scope, the variables are referred in the pipelines
+// using the expression <+variable.name>, <+variable.org.name> or <+variable.account.name>.
+// Refer to https://apidocs.harness.io/tag/Variables for API
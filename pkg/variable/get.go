@@ -0,0 +1,94 @@
+package variable
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type GetOptions struct {
+	types.ScopeOptions
+	Name string
+}
+
+// GetVariable gets the variable
+type GetVariable struct {
+	types.ScopedResource
+	// The identifier of the variable
+	Identifier string
+}
+
+// Call implements types.RESTCall
+func (gv *GetVariable) Call() (map[string]interface{}, error) {
+	return utils.GetResourceByID(gv.Request(), variableURL, gv.Identifier)
+}
+
+// Print implements types.RESTCall
+func (gv *GetVariable) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	data := rm["data"].(map[string]interface{})
+	if v, ok := data["variable"].(map[string]interface{}); ok {
+		en := json.NewEncoder(os.Stdout)
+		en.Encode(variableSummary(v))
+	}
+}
+
+// AddFlags implements types.Command
+func (gvo *GetOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&gvo.Name, "name", "n", "", "The identifier of the variable.")
+	cmd.MarkFlagRequired("name")
+	gvo.ScopeOptions.AddFlags(cmd, "variable")
+}
+
+// Execute implements types.Command
+func (gvo *GetOptions) Execute(cmd *cobra.Command, args []string) error {
+	gv := &GetVariable{
+		ScopedResource: gvo.Resource(),
+		Identifier:     gvo.Name,
+	}
+
+	gv.Print(gv.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (gvo *GetOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return gvo.ScopeOptions.Validate()
+}
+
+var getCommandExample = fmt.Sprintf(`
+# Get the project variable
+%[1]s variable get --name region --account-id <your account id> --project-id <project id>
+# Get the organization variable
+%[1]s variable get --name region --account-id <your account id> --variable-scope org
+`, common.ExamplePrefix())
+
+// newGetCommand instantiates the new instance of the newGetCommand
+func newGetCommand() *cobra.Command {
+	gvo := &GetOptions{}
+
+	vCmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Gets a variable.",
+		Example: getCommandExample,
+		RunE:    gvo.Execute,
+		PreRunE: gvo.Validate,
+	}
+
+	gvo.AddFlags(vCmd)
+
+	return vCmd
+}
+
+var _ types.Command = (*GetOptions)(nil)
+var _ types.RESTCall = (*GetVariable)(nil)
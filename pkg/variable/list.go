@@ -0,0 +1,101 @@
+package variable
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type ListOptions struct {
+	types.ScopeOptions
+}
+
+// ListVariables lists the variables of the scope
+type ListVariables struct {
+	types.ScopedResource
+}
+
+// Call implements types.RESTCall
+func (lv *ListVariables) Call() (map[string]interface{}, error) {
+	return utils.ListPages(func(index int) (map[string]interface{}, error) {
+		req := lv.Request()
+		req.SetQueryParam("pageIndex", fmt.Sprint(index))
+		req.SetQueryParam("pageSize", "100")
+		return utils.GetJSON(req, variablesURL)
+	}, nil, 0)
+}
+
+// Print implements types.RESTCall
+func (lv *ListVariables) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+
+	resMap := []map[string]interface{}{}
+	data := rm["data"].(map[string]interface{})
+	if content, ok := data["content"].([]interface{}); ok {
+		for _, c := range content {
+			if v, ok := c.(map[string]interface{})["variable"].(map[string]interface{}); ok {
+				resMap = append(resMap, variableSummary(v))
+			}
+		}
+	}
+
+	en := json.NewEncoder(os.Stdout)
+	en.Encode(resMap)
+}
+
+// AddFlags implements types.Command
+func (lo *ListOptions) AddFlags(cmd *cobra.Command) {
+	lo.ScopeOptions.AddFlags(cmd, "variable")
+}
+
+// Execute implements types.Command
+func (lo *ListOptions) Execute(cmd *cobra.Command, args []string) error {
+	lv := &ListVariables{
+		ScopedResource: lo.Resource(),
+	}
+
+	lv.Print(lv.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (lo *ListOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+	return lo.ScopeOptions.Validate()
+}
+
+var listCommandExample = fmt.Sprintf(`
+# List the project variables
+%[1]s variable list --account-id <your account id> --project-id <project id>
+# List the account variables
+%[1]s variable list --account-id <your account id> --variable-scope account
+`, common.ExamplePrefix())
+
+// newListCommand instantiates the new instance of the newListCommand
+func newListCommand() *cobra.Command {
+	lo := &ListOptions{}
+
+	vCmd := &cobra.Command{
+		Use:     "list",
+		Short:   "Lists the variables.",
+		Example: listCommandExample,
+		RunE:    lo.Execute,
+		PreRunE: lo.Validate,
+	}
+
+	lo.AddFlags(vCmd)
+
+	return vCmd
+}
+
+var _ types.Command = (*ListOptions)(nil)
+var _ types.RESTCall = (*ListVariables)(nil)
@@ -0,0 +1,136 @@
+package variable
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+type CreateOptions struct {
+	types.ScopeOptions
+	Name        string
+	Description string
+	// The variable type, only "String" is supported
+	Type string
+	// The fixed value of the variable
+	Value string
+}
+
+// VariableInfo is the wrapper to hold the variable details
+type VariableInfo struct {
+	types.ScopedResource
+	Variable Variable `json:"variable"`
+}
+
+// Variable holds the resource data of a Variable resource
+type Variable struct {
+	Identifier        string `json:"identifier"`
+	Name              string `json:"name"`
+	Description       string `json:"description,omitempty"`
+	OrgIdentifier     string `json:"orgIdentifier,omitempty"`
+	ProjectIdentifier string `json:"projectIdentifier,omitempty"`
+	Type              string `json:"type"`
+	Spec              Spec   `json:"spec"`
+}
+
+// Spec holds the value of the variable
+type Spec struct {
+	// Always "FIXED"
+	ValueType  string `json:"valueType"`
+	FixedValue string `json:"fixedValue"`
+}
+
+// Call implements types.RESTCall
+func (vi *VariableInfo) Call() (map[string]interface{}, error) {
+	return utils.PostJSON(vi.Request(), variablesURL, vi)
+}
+
+// Print implements types.RESTCall
+func (vi *VariableInfo) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Variable \"%s\" created successfully\n", vi.Variable.Identifier)
+}
+
+// AddFlags implements types.Command
+func (co *CreateOptions) AddFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&co.Name, "name", "n", "", "The name of the variable.")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().StringVarP(&co.Description, "description", "d", "", "The description of the variable.")
+	cmd.Flags().StringVarP(&co.Value, "value", "", "", "The fixed value of the variable.")
+	cmd.MarkFlagRequired("value")
+	cmd.Flags().StringVarP(&co.Type, "variable-type", "", stringVariableType, `The variable type. Valid value is "String"`)
+	co.ScopeOptions.AddFlags(cmd, "variable")
+}
+
+// variableInfo builds the VariableInfo from the flags
+func (co *CreateOptions) variableInfo() *VariableInfo {
+	r := co.Resource()
+	return &VariableInfo{
+		ScopedResource: r,
+		Variable: Variable{
+			Identifier:        utils.IDFromName(co.Name),
+			Name:              co.Name,
+			Description:       co.Description,
+			OrgIdentifier:     r.OrgID,
+			ProjectIdentifier: r.ProjectID,
+			Type:              co.Type,
+			Spec: Spec{
+				ValueType:  fixedValueType,
+				FixedValue: co.Value,
+			},
+		},
+	}
+}
+
+// Execute implements types.Command
+func (co *CreateOptions) Execute(cmd *cobra.Command, args []string) error {
+	vi := co.variableInfo()
+
+	vi.Print(vi.Call())
+
+	return nil
+}
+
+// Validate implements types.Command
+func (co *CreateOptions) Validate(cmd *cobra.Command, args []string) error {
+	viper.BindPFlags(cmd.Flags())
+
+	if co.Type != stringVariableType {
+		return fmt.Errorf(`"variable-type" should be "String"`)
+	}
+
+	return co.ScopeOptions.Validate()
+}
+
+var createCommandExample = fmt.Sprintf(`
+# Create a project variable, referred as <+variable.region>
+%[1]s variable new --name region --value us-east-1 --account-id <your account id> --project-id <project id>
+# Create an account variable, referred as <+variable.account.registry>
+%[1]s variable new --name registry --value docker.io/example --account-id <your account id> --variable-scope account
+`, common.ExamplePrefix())
+
+// newCreateCommand instantiates the new instance of the newCreateCommand
+func newCreateCommand() *cobra.Command {
+	co := &CreateOptions{}
+
+	vCmd := &cobra.Command{
+		Use:     "new",
+		Short:   "Creates a new variable with a fixed value.",
+		Example: createCommandExample,
+		RunE:    co.Execute,
+		PreRunE: co.Validate,
+	}
+
+	co.AddFlags(vCmd)
+
+	return vCmd
+}
+
+var _ types.Command = (*CreateOptions)(nil)
+var _ types.RESTCall = (*VariableInfo)(nil)
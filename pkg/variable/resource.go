@@ -0,0 +1,23 @@
+package variable
+
+const (
+	variablesURL = "https://app.harness.io/gateway/ng/api/variables"
+	variableURL  = "https://app.harness.io/gateway/ng/api/variables/{id}"
+
+	stringVariableType = "String"
+	fixedValueType     = "FIXED"
+)
+
+// variableSummary returns the summary of the variable response used by the get and list commands
+func variableSummary(v map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{
+		"name":        v["name"],
+		"id":          v["identifier"],
+		"description": v["description"],
+		"type":        v["type"],
+	}
+	if spec, ok := v["spec"].(map[string]interface{}); ok {
+		res["value"] = spec["fixedValue"]
+	}
+	return res
+}
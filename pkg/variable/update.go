@@ -0,0 +1,66 @@
+package variable
+
+import (
+	"fmt"
+
+	"github.com/kameshsampath/harness-cli/pkg/common"
+	"github.com/kameshsampath/harness-cli/pkg/types"
+	"github.com/kameshsampath/harness-cli/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+type UpdateOptions struct {
+	CreateOptions
+}
+
+// UpdateVariable updates the variable
+type UpdateVariable struct {
+	*VariableInfo
+}
+
+// Call implements types.RESTCall
+func (uv *UpdateVariable) Call() (map[string]interface{}, error) {
+	return utils.PutJSON(uv.Request(), variablesURL, uv.VariableInfo)
+}
+
+// Print implements types.RESTCall
+func (uv *UpdateVariable) Print(rm map[string]interface{}, err error) {
+	if types.PrintError(rm, err) {
+		return
+	}
+	fmt.Printf("Variable \"%s\" updated successfully\n", uv.Variable.Identifier)
+}
+
+// Execute implements types.Command
+func (uo *UpdateOptions) Execute(cmd *cobra.Command, args []string) error {
+	uv := &UpdateVariable{uo.variableInfo()}
+
+	uv.Print(uv.Call())
+
+	return nil
+}
+
+var updateCommandExample = fmt.Sprintf(`
+# Update the value of the project variable
+%[1]s variable update --name region --value eu-west-1 --account-id <your account id> --project-id <project id>
+`, common.ExamplePrefix())
+
+// newUpdateCommand instantiates the new instance of the newUpdateCommand
+func newUpdateCommand() *cobra.Command {
+	uo := &UpdateOptions{}
+
+	vCmd := &cobra.Command{
+		Use:     "update",
+		Short:   "Updates the value and the description of a variable.",
+		Example: updateCommandExample,
+		RunE:    uo.Execute,
+		PreRunE: uo.Validate,
+	}
+
+	uo.AddFlags(vCmd)
+
+	return vCmd
+}
+
+var _ types.Command = (*UpdateOptions)(nil)
+var _ types.RESTCall = (*UpdateVariable)(nil)